@@ -0,0 +1,63 @@
+terraform {
+  required_version = ">=1.1"
+  required_providers {
+    pingaccess = {
+      version = "~> 0.0.1"
+      source  = "pingidentity/pingaccess"
+    }
+  }
+}
+
+provider "pingaccess" {
+  username           = "administrator"
+  password           = "2Access"
+  https_host         = "https://localhost:9000"
+  insecure_trust_all = true
+}
+
+resource "pingaccess_acme_servers" "letsEncryptExample" {
+  name = "Let's Encrypt"
+  url  = "https://acme-v02.api.letsencrypt.org/directory"
+}
+
+# import by <acme_server_id>/<id>
+resource "pingaccess_acme_account" "acmeAccountExample" {
+  acme_server_id            = pingaccess_acme_servers.letsEncryptExample.id
+  key_algorithm             = "RSA"
+  contacts                  = ["mailto:admin@example.com"]
+  terms_of_service_accepted = true
+}
+
+resource "pingaccess_keypair" "keyPairExample" {
+  alias = "acmeexample"
+  generate = {
+    common_name   = "www.example.com"
+    organization  = "Example"
+    country       = "US"
+    key_algorithm = "RSA"
+    key_size      = 2048
+    valid_days    = 90
+  }
+}
+
+# Waits until the ACME server issues the certificate, which is imported into the key pair
+# import by <acme_server_id>/<acme_account_id>/<id>
+resource "pingaccess_acme_certificate_request" "acmeCertificateRequestExample" {
+  acme_server_id  = pingaccess_acme_servers.letsEncryptExample.id
+  acme_account_id = pingaccess_acme_account.acmeAccountExample.id
+  keypair_id      = pingaccess_keypair.keyPairExample.id
+
+  timeouts {
+    create = "10m"
+  }
+}
+
+resource "pingaccess_virtualhosts" "virtualHostExample" {
+  host       = "www.example.com"
+  port       = 443
+  keypair_id = pingaccess_acme_certificate_request.acmeCertificateRequestExample.keypair_id
+}
+
+output "certificate_expires" {
+  value = pingaccess_acme_certificate_request.acmeCertificateRequestExample.expires
+}
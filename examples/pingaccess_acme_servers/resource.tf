@@ -12,6 +12,7 @@ provider "pingaccess" {
   username = "administrator"
   password = "2Access"
   https_host = "https://localhost:9000"
+  insecure_trust_all = true
 }
 
 resource "pingaccess_acme_servers" "acmeserversExample" {
@@ -0,0 +1,42 @@
+terraform {
+  required_version = ">=1.1"
+  required_providers {
+    pingaccess = {
+      version = "~> 0.0.1"
+      source  = "pingidentity/pingaccess"
+    }
+  }
+}
+
+provider "pingaccess" {
+  username           = "administrator"
+  password           = "2Access"
+  https_host         = "https://localhost:9000"
+  insecure_trust_all = true
+}
+
+resource "pingaccess_virtualhosts" "virtualHostExample" {
+  host = "www.example.com"
+  port = 443
+}
+
+resource "pingaccess_sites" "siteExample" {
+  name    = "example"
+  targets = ["backend.example.com:443"]
+  secure  = true
+}
+
+# import by id, or by name with an import id of name:<name>
+resource "pingaccess_application" "applicationExample" {
+  name                = "example"
+  description         = "Example application"
+  context_root        = "/example"
+  virtual_host_ids    = [pingaccess_virtualhosts.virtualHostExample.id]
+  application_type    = "Web"
+  destination         = "Site"
+  site_id             = pingaccess_sites.siteExample.id
+  default_auth_type   = "Web"
+  case_sensitive_path = true
+  spa_support_enabled = false
+  enabled             = true
+}
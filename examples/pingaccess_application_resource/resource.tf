@@ -0,0 +1,79 @@
+terraform {
+  required_version = ">=1.1"
+  required_providers {
+    pingaccess = {
+      version = "~> 0.0.1"
+      source  = "pingidentity/pingaccess"
+    }
+  }
+}
+
+provider "pingaccess" {
+  username           = "administrator"
+  password           = "2Access"
+  https_host         = "https://localhost:9000"
+  insecure_trust_all = true
+}
+
+resource "pingaccess_virtualhosts" "virtualHostExample" {
+  host = "api.example.com"
+  port = 443
+}
+
+resource "pingaccess_sites" "siteExample" {
+  name    = "api"
+  targets = ["backend.example.com:443"]
+  secure  = true
+}
+
+resource "pingaccess_application" "applicationExample" {
+  name              = "api"
+  context_root      = "/api"
+  virtual_host_ids  = [pingaccess_virtualhosts.virtualHostExample.id]
+  application_type  = "API"
+  site_id           = pingaccess_sites.siteExample.id
+  default_auth_type = "API"
+}
+
+# import by <application_id>/<id>
+resource "pingaccess_application_resource" "healthExample" {
+  application_id = pingaccess_application.applicationExample.id
+  name           = "health"
+  methods        = ["GET"]
+  path_patterns = [
+    {
+      pattern = "/health"
+      type    = "WILDCARD"
+    }
+  ]
+  unprotected = true
+  audit_level = "OFF"
+}
+
+resource "pingaccess_application_resource" "versionedExample" {
+  application_id = pingaccess_application.applicationExample.id
+  name           = "versioned"
+  methods        = ["GET", "POST"]
+  path_patterns = [
+    {
+      pattern = "/v[0-9]+/orders/.*"
+      type    = "REGEX"
+    }
+  ]
+  priority = 1
+}
+
+# The default resource is created along with the application, so it is adopted rather than created
+resource "pingaccess_application_resource" "defaultExample" {
+  application_id = pingaccess_application.applicationExample.id
+  name           = "Root Resource"
+  methods        = ["*"]
+  path_patterns = [
+    {
+      pattern = "/*"
+      type    = "WILDCARD"
+    }
+  ]
+  root_resource = true
+  audit_level   = "ON"
+}
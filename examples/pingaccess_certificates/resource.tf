@@ -12,10 +12,15 @@ provider "pingaccess" {
   username = "administrator"
   password = "2Access"
   https_host = "https://localhost:9000"
+  insecure_trust_all = true
 }
-# this resource does not support import
+# import by id, or by alias with an import id of alias:<alias>
 resource "pingaccess_certificates" "example" {
   alias     = "test"
   # this property needs to contain base64 encode value of your pem certificate
   file_data = ""
-}
\ No newline at end of file
+}
+
+output "certificate_expires_soon" {
+  value = timecmp(pingaccess_certificates.example.expires, timeadd(timestamp(), "720h")) < 0
+}
@@ -0,0 +1,48 @@
+terraform {
+  required_version = ">=1.1"
+  required_providers {
+    pingaccess = {
+      version = "~> 0.0.1"
+      source  = "pingidentity/pingaccess"
+    }
+  }
+}
+
+provider "pingaccess" {
+  username           = "administrator"
+  password           = "2Access"
+  https_host         = "https://localhost:9000"
+  insecure_trust_all = true
+}
+
+# Key pair generated by PingAccess
+resource "pingaccess_keypair" "generatedKeyPairExample" {
+  alias = "generatedexample"
+  generate = {
+    common_name   = "example.com"
+    organization  = "Example"
+    country       = "US"
+    key_algorithm = "RSA"
+    key_size      = 2048
+    valid_days    = 365
+    subject_alternative_names = [
+      {
+        name  = "DNSName"
+        value = "www.example.com"
+      }
+    ]
+  }
+}
+
+variable "keypair_password" {
+  type      = string
+  sensitive = true
+}
+
+# Key pair imported from a PKCS#12 file
+# import by id, or by alias with an import id of alias:<alias>
+resource "pingaccess_keypair" "importedKeyPairExample" {
+  alias     = "importedexample"
+  file_data = filebase64("keypair.p12")
+  password  = var.keypair_password
+}
@@ -0,0 +1,61 @@
+terraform {
+  required_version = ">=1.1"
+  required_providers {
+    pingaccess = {
+      version = "~> 0.0.1"
+      source  = "pingidentity/pingaccess"
+    }
+    tls = {
+      source = "hashicorp/tls"
+    }
+  }
+}
+
+provider "pingaccess" {
+  username           = "administrator"
+  password           = "2Access"
+  https_host         = "https://localhost:9000"
+  insecure_trust_all = true
+}
+
+variable "ca_private_key_pem" {
+  type      = string
+  sensitive = true
+}
+
+variable "ca_cert_pem" {
+  type = string
+}
+
+resource "pingaccess_keypair" "keyPairExample" {
+  alias = "csrexample"
+  generate = {
+    common_name   = "example.com"
+    organization  = "Example"
+    country       = "US"
+    key_algorithm = "RSA"
+    key_size      = 2048
+    valid_days    = 365
+  }
+}
+
+# Generate a CSR for the key pair
+data "pingaccess_keypair_csr" "csrExample" {
+  keypair_id = pingaccess_keypair.keyPairExample.id
+}
+
+# Sign the CSR with the CA
+resource "tls_locally_signed_cert" "signedExample" {
+  cert_request_pem      = data.pingaccess_keypair_csr.csrExample.csr
+  ca_private_key_pem    = var.ca_private_key_pem
+  ca_cert_pem           = var.ca_cert_pem
+  validity_period_hours = 8760
+  allowed_uses          = ["digital_signature", "key_encipherment", "server_auth"]
+}
+
+# Import the signed certificate, along with its issuer, back into the key pair
+resource "pingaccess_keypair_csr_response" "csrResponseExample" {
+  keypair_id         = pingaccess_keypair.keyPairExample.id
+  file_data          = base64encode(tls_locally_signed_cert.signedExample.cert_pem)
+  chain_certificates = [base64encode(var.ca_cert_pem)]
+}
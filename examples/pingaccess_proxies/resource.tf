@@ -11,6 +11,7 @@ provider "pingaccess" {
   username = "administrator"
   password = "2Access"
   https_host = "https://localhost:9000"
+  insecure_trust_all = true
 }
 
 # WARNING! You will need to secure your state file properly when using this resource! #
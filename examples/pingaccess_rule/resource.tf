@@ -0,0 +1,35 @@
+terraform {
+  required_version = ">=1.1"
+  required_providers {
+    pingaccess = {
+      version = "~> 0.0.1"
+      source  = "pingidentity/pingaccess"
+    }
+  }
+}
+
+provider "pingaccess" {
+  username           = "administrator"
+  password           = "2Access"
+  https_host         = "https://localhost:9000"
+  insecure_trust_all = true
+}
+
+# The class names and configuration fields of each Rule type are listed by the /rules/descriptors endpoint
+# of the PingAccess admin API. import by id, or by name with an import id of name:<name>
+resource "pingaccess_rule" "networkRangeExample" {
+  name      = "Internal network"
+  classname = "com.pingidentity.pa.policy.CIDRPolicyInterceptor"
+  configuration = jsonencode({
+    cidrNotation = "10.0.0.0/8"
+    negate       = false
+  })
+}
+
+resource "pingaccess_rule" "oauthScopeExample" {
+  name      = "Read scope"
+  classname = "com.pingidentity.pa.policy.oauth.OAuthPolicyInterceptor"
+  configuration = jsonencode({
+    scope = "read"
+  })
+}
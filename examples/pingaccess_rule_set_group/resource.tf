@@ -0,0 +1,50 @@
+terraform {
+  required_version = ">=1.1"
+  required_providers {
+    pingaccess = {
+      version = "~> 0.0.1"
+      source  = "pingidentity/pingaccess"
+    }
+  }
+}
+
+provider "pingaccess" {
+  username           = "administrator"
+  password           = "2Access"
+  https_host         = "https://localhost:9000"
+  insecure_trust_all = true
+}
+
+resource "pingaccess_rule" "networkExample" {
+  name      = "Internal network"
+  classname = "com.pingidentity.pa.policy.CIDRPolicyInterceptor"
+  configuration = jsonencode({
+    cidrNotation = "10.0.0.0/8"
+    negate       = false
+  })
+}
+
+resource "pingaccess_ruleset" "networkRuleSetExample" {
+  name     = "Trusted networks"
+  rule_ids = [pingaccess_rule.networkExample.id]
+}
+
+resource "pingaccess_rule" "scopeExample" {
+  name      = "Read scope"
+  classname = "com.pingidentity.pa.policy.oauth.OAuthPolicyInterceptor"
+  configuration = jsonencode({
+    scope = "read"
+  })
+}
+
+resource "pingaccess_ruleset" "scopeRuleSetExample" {
+  name     = "Read access"
+  rule_ids = [pingaccess_rule.scopeExample.id]
+}
+
+# import by id, or by name with an import id of name:<name>
+resource "pingaccess_rule_set_group" "ruleSetGroupExample" {
+  name             = "Trusted readers"
+  rule_set_ids     = [pingaccess_ruleset.networkRuleSetExample.id, pingaccess_ruleset.scopeRuleSetExample.id]
+  success_criteria = "SuccessIfAllSucceed"
+}
@@ -0,0 +1,42 @@
+terraform {
+  required_version = ">=1.1"
+  required_providers {
+    pingaccess = {
+      version = "~> 0.0.1"
+      source  = "pingidentity/pingaccess"
+    }
+  }
+}
+
+provider "pingaccess" {
+  username           = "administrator"
+  password           = "2Access"
+  https_host         = "https://localhost:9000"
+  insecure_trust_all = true
+}
+
+resource "pingaccess_rule" "internalNetworkExample" {
+  name      = "Internal network"
+  classname = "com.pingidentity.pa.policy.CIDRPolicyInterceptor"
+  configuration = jsonencode({
+    cidrNotation = "10.0.0.0/8"
+    negate       = false
+  })
+}
+
+resource "pingaccess_rule" "officeNetworkExample" {
+  name      = "Office network"
+  classname = "com.pingidentity.pa.policy.CIDRPolicyInterceptor"
+  configuration = jsonencode({
+    cidrNotation = "192.168.0.0/16"
+    negate       = false
+  })
+}
+
+# import by id, or by name with an import id of name:<name>
+resource "pingaccess_ruleset" "ruleSetExample" {
+  name             = "Trusted networks"
+  rule_ids         = [pingaccess_rule.internalNetworkExample.id, pingaccess_rule.officeNetworkExample.id]
+  success_criteria = "SuccessIfAnyOneSucceeds"
+  element_type     = "Web"
+}
@@ -12,9 +12,25 @@ provider "pingaccess" {
   username = "administrator"
   password = "2Access"
   https_host = "https://localhost:9000"
+  insecure_trust_all = true
+  request_timeout = "30s"
 }
 
 resource "pingaccess_sites" "siteExample" {
 	name = "example"	
 	targets = ["localhost:80","localhost:443"]
-}
\ No newline at end of file
+
+	timeouts {
+		create = "5m"
+		delete = "5m"
+	}
+}
+
+data "pingaccess_site" "siteLookup" {
+	name = pingaccess_sites.siteExample.name
+}
+
+data "pingaccess_sites" "allSites" {
+	sort_key = "name"
+	order = "ASC"
+}
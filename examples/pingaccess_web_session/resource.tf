@@ -0,0 +1,46 @@
+terraform {
+  required_version = ">=1.1"
+  required_providers {
+    pingaccess = {
+      version = "~> 0.0.1"
+      source  = "pingidentity/pingaccess"
+    }
+  }
+}
+
+provider "pingaccess" {
+  username           = "administrator"
+  password           = "2Access"
+  https_host         = "https://localhost:9000"
+  insecure_trust_all = true
+}
+
+variable "web_session_client_secret" {
+  type      = string
+  sensitive = true
+}
+
+# import by id, or by name with an import id of name:<name>. The client secret can't be imported.
+resource "pingaccess_web_session" "webSessionExample" {
+  name     = "example"
+  audience = "example"
+  client_credentials = {
+    client_id        = "pingaccess"
+    credentials_type = "SECRET"
+    client_secret = {
+      value = var.web_session_client_secret
+    }
+  }
+  oidc_login_type                   = "Code"
+  pkce_challenge_type               = "SHA256"
+  scopes                            = ["profile", "email"]
+  request_preservation_type         = "POST"
+  cookie_type                       = "Encrypted"
+  secure_cookie                     = true
+  http_only_cookie                  = true
+  same_site                         = "Lax"
+  idle_timeout_in_minutes           = 60
+  session_timeout_in_minutes        = 240
+  enable_refresh_user               = true
+  refresh_user_info_claims_interval = 60
+}
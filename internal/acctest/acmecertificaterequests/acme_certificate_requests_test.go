@@ -0,0 +1,132 @@
+package acctest_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/provider"
+)
+
+// Directory URL of an ACME test server reachable from PingAccess, such as the pebble service in docker-compose
+const acmeServerUrlEnvVar = "PINGACCESS_ACME_TEST_SERVER_URL"
+
+func TestAccAcmeCertificateRequest(t *testing.T) {
+	resourceName := "myAcmeCertificateRequest"
+	acmeServerUrl := os.Getenv(acmeServerUrlEnvVar)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.ConfigurationPreCheck(t)
+			if acmeServerUrl == "" {
+				t.Skip(acmeServerUrlEnvVar + " must be set to run ACME acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingaccess": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckAcmeCertificateRequestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAcmeCertificateRequest(resourceName, acmeServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingaccess_acme_certificate_request."+resourceName, "status", "VALID"),
+					resource.TestCheckResourceAttrSet("pingaccess_acme_certificate_request."+resourceName, "certificate"),
+					resource.TestCheckResourceAttrSet("pingaccess_acme_certificate_request."+resourceName, "expires"),
+					resource.TestCheckResourceAttrPair("pingaccess_acme_certificate_request."+resourceName, "keypair_id", "pingaccess_keypair."+resourceName, "id"),
+					resource.TestCheckResourceAttrSet("pingaccess_acme_account."+resourceName, "url"),
+				),
+			},
+			{
+				// Test importing the account
+				Config:            testAccAcmeCertificateRequest(resourceName, acmeServerUrl),
+				ResourceName:      "pingaccess_acme_account." + resourceName,
+				ImportStateIdFunc: testAccAcmeImportStateId("pingaccess_acme_account."+resourceName, "acme_server_id"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Test importing the certificate request
+				Config:            testAccAcmeCertificateRequest(resourceName, acmeServerUrl),
+				ResourceName:      "pingaccess_acme_certificate_request." + resourceName,
+				ImportStateIdFunc: testAccAcmeImportStateId("pingaccess_acme_certificate_request."+resourceName, "acme_server_id", "acme_account_id"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAcmeCertificateRequest(resourceName, acmeServerUrl string) string {
+	return fmt.Sprintf(`
+resource "pingaccess_acme_servers" "%[1]s" {
+  name = "terraformacmetest"
+  url  = "%[2]s"
+}
+
+resource "pingaccess_acme_account" "%[1]s" {
+  acme_server_id            = pingaccess_acme_servers.%[1]s.id
+  key_algorithm             = "RSA"
+  contacts                  = ["mailto:terraformtest@example.com"]
+  terms_of_service_accepted = true
+}
+
+resource "pingaccess_keypair" "%[1]s" {
+  alias = "terraformacmetest"
+  generate = {
+    common_name   = "terraformacmetest.example.com"
+    organization  = "Ping Identity"
+    country       = "US"
+    key_algorithm = "RSA"
+    key_size      = 2048
+    valid_days    = 90
+  }
+}
+
+resource "pingaccess_acme_certificate_request" "%[1]s" {
+  acme_server_id  = pingaccess_acme_servers.%[1]s.id
+  acme_account_id = pingaccess_acme_account.%[1]s.id
+  keypair_id      = pingaccess_keypair.%[1]s.id
+}`, resourceName, acmeServerUrl)
+}
+
+// Build the import ID of a nested ACME object from its parent IDs and its own ID
+func testAccAcmeImportStateId(resourceAddress string, parentAttributes ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceAddress]
+		if !ok {
+			return "", fmt.Errorf("%s not found in state", resourceAddress)
+		}
+		importId := ""
+		for _, parentAttribute := range parentAttributes {
+			importId += rs.Primary.Attributes[parentAttribute] + "/"
+		}
+		return importId + rs.Primary.ID, nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckAcmeCertificateRequestDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	for _, rs := range s.RootModule().Resources {
+		switch rs.Type {
+		case "pingaccess_acme_account":
+			_, _, err := testClient.AcmeApi.GetAcmeAccount(ctx, rs.Primary.Attributes["acme_server_id"], rs.Primary.ID).Execute()
+			if err == nil {
+				return acctest.ExpectedDestroyError("ACME Account", rs.Primary.ID)
+			}
+		case "pingaccess_acme_certificate_request":
+			_, _, err := testClient.AcmeApi.GetAcmeCertificateRequest(ctx, rs.Primary.Attributes["acme_server_id"], rs.Primary.Attributes["acme_account_id"], rs.Primary.ID).Execute()
+			if err == nil {
+				return acctest.ExpectedDestroyError("ACME certificate request", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package acctest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/provider"
+)
+
+// Attributes to test with. Add optional properties to test here if desired.
+type applicationResourceResourceModel struct {
+	name        string
+	methods     []string
+	pattern     string
+	patternType string
+	priority    int64
+	unprotected bool
+}
+
+func TestAccApplicationResource(t *testing.T) {
+	resourceName := "myApplicationResource"
+	initialResourceModel := applicationResourceResourceModel{
+		name:        "example",
+		methods:     []string{"GET"},
+		pattern:     "/example/*",
+		patternType: "WILDCARD",
+		unprotected: false,
+	}
+	updatedResourceModel := applicationResourceResourceModel{
+		name:        "updatedexample",
+		methods:     []string{"GET", "POST"},
+		pattern:     "/v[0-9]+/example/.*",
+		patternType: "REGEX",
+		priority:    1,
+		unprotected: true,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingaccess": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckApplicationResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationResource(resourceName, initialResourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExpectedApplicationResourceAttributes(resourceName, initialResourceModel),
+					resource.TestCheckResourceAttr("pingaccess_application_resource."+resourceName+"Root", "root_resource", "true"),
+				),
+			},
+			{
+				// Test updating some fields
+				Config: testAccApplicationResource(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedApplicationResourceAttributes(resourceName, updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccApplicationResource(resourceName, updatedResourceModel),
+				ResourceName:      "pingaccess_application_resource." + resourceName,
+				ImportStateIdFunc: testAccApplicationResourceImportStateId("pingaccess_application_resource." + resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccApplicationResource(resourceName string, resourceModel applicationResourceResourceModel) string {
+	priority := ""
+	if resourceModel.priority != 0 {
+		priority = fmt.Sprintf("priority = %d", resourceModel.priority)
+	}
+	return fmt.Sprintf(`
+resource "pingaccess_virtualhosts" "%[1]s" {
+  host = "applicationresourcetest"
+  port = 4322
+}
+
+resource "pingaccess_sites" "%[1]s" {
+  name    = "applicationresourcetest"
+  targets = ["localhost:80"]
+}
+
+resource "pingaccess_application" "%[1]s" {
+  name              = "applicationresourcetest"
+  context_root      = "/applicationresourcetest"
+  virtual_host_ids  = [pingaccess_virtualhosts.%[1]s.id]
+  site_id           = pingaccess_sites.%[1]s.id
+  default_auth_type = "Web"
+}
+
+resource "pingaccess_application_resource" "%[1]s" {
+  application_id = pingaccess_application.%[1]s.id
+  name           = "%[2]s"
+  methods        = %[3]s
+  path_patterns = [
+    {
+      pattern = "%[4]s"
+      type    = "%[5]s"
+    }
+  ]
+  unprotected = %[6]t
+  %[7]s
+}
+
+resource "pingaccess_application_resource" "%[1]sRoot" {
+  application_id = pingaccess_application.%[1]s.id
+  name           = "Root Resource"
+  methods        = ["*"]
+  path_patterns = [
+    {
+      pattern = "/*"
+      type    = "WILDCARD"
+    }
+  ]
+  root_resource = true
+}`, resourceName,
+		resourceModel.name,
+		acctest.StringSliceToTerraformString(resourceModel.methods),
+		resourceModel.pattern,
+		resourceModel.patternType,
+		resourceModel.unprotected,
+		priority)
+}
+
+// Build the <application_id>/<id> import ID of an Application Resource
+func testAccApplicationResourceImportStateId(resourceAddress string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceAddress]
+		if !ok {
+			return "", fmt.Errorf("%s not found in state", resourceAddress)
+		}
+		return rs.Primary.Attributes["application_id"] + "/" + rs.Primary.ID, nil
+	}
+}
+
+// Test that the expected attributes are set on the PingAccess server
+func testAccCheckExpectedApplicationResourceAttributes(resourceName string, config applicationResourceResourceModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resourceType := "Application Resource"
+		rs, ok := s.RootModule().Resources["pingaccess_application_resource."+resourceName]
+		if !ok {
+			return fmt.Errorf("%s %s not found in state", resourceType, resourceName)
+		}
+		stateId := rs.Primary.ID
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.ApplicationsApi.GetApplicationResource(ctx, rs.Primary.Attributes["application_id"], stateId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		// Verify that attributes have expected values
+		err = acctest.TestAttributesMatchString(resourceType, &stateId, "name",
+			config.name, response.Name)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchStringSlice(resourceType, &stateId, "methods",
+			config.methods, response.Methods)
+		if err != nil {
+			return err
+		}
+		if len(response.PathPatterns) != 1 {
+			return fmt.Errorf("expected %s %s to have 1 path pattern, found %d", resourceType, stateId, len(response.PathPatterns))
+		}
+		err = acctest.TestAttributesMatchStringPointer(resourceType, &stateId, "path_patterns.pattern",
+			config.pattern, response.PathPatterns[0].Pattern)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchStringPointer(resourceType, &stateId, "path_patterns.type",
+			config.patternType, response.PathPatterns[0].Type)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchBool(resourceType, &stateId, "unprotected",
+			config.unprotected, response.GetUnprotected())
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckApplicationResourceDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "pingaccess_application_resource" {
+			continue
+		}
+		_, _, err := testClient.ApplicationsApi.GetApplicationResource(ctx, rs.Primary.Attributes["application_id"], rs.Primary.ID).Execute()
+		if err == nil {
+			return acctest.ExpectedDestroyError("Application Resource", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package acctest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/provider"
+)
+
+const applicationId = "10"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type applicationResourceModel struct {
+	id                int64
+	name              string
+	contextRoot       string
+	caseSensitivePath bool
+	enabled           bool
+	stateId           string
+}
+
+func TestAccApplication(t *testing.T) {
+	resourceName := "myApplication"
+	initialResourceModel := applicationResourceModel{
+		id:                10,
+		name:              "example",
+		contextRoot:       "/example",
+		caseSensitivePath: false,
+		enabled:           true,
+		stateId:           applicationId,
+	}
+	updatedResourceModel := applicationResourceModel{
+		id:                10,
+		name:              "updatedexample",
+		contextRoot:       "/updatedexample",
+		caseSensitivePath: true,
+		enabled:           false,
+		stateId:           applicationId,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingaccess": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplication(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedApplicationAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccApplication(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedApplicationAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccApplication(resourceName, updatedResourceModel),
+				ResourceName:      "pingaccess_application." + resourceName,
+				ImportStateId:     applicationId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Test importing the resource by name
+				Config:            testAccApplication(resourceName, updatedResourceModel),
+				ResourceName:      "pingaccess_application." + resourceName,
+				ImportStateId:     "name:" + updatedResourceModel.name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccApplication(resourceName string, resourceModel applicationResourceModel) string {
+	return fmt.Sprintf(`
+resource "pingaccess_virtualhosts" "%[1]s" {
+  host = "applicationtest"
+  port = 4321
+}
+
+resource "pingaccess_sites" "%[1]s" {
+  name    = "applicationtest"
+  targets = ["localhost:80"]
+}
+
+resource "pingaccess_application" "%[1]s" {
+  id                  = "%[2]d"
+  name                = "%[3]s"
+  context_root        = "%[4]s"
+  virtual_host_ids    = [pingaccess_virtualhosts.%[1]s.id]
+  destination         = "Site"
+  site_id             = pingaccess_sites.%[1]s.id
+  default_auth_type   = "Web"
+  case_sensitive_path = %[5]t
+  enabled             = %[6]t
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.name,
+		resourceModel.contextRoot,
+		resourceModel.caseSensitivePath,
+		resourceModel.enabled)
+}
+
+// Test that the expected attributes are set on the PingAccess server
+func testAccCheckExpectedApplicationAttributes(config applicationResourceModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resourceType := "Application"
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.ApplicationsApi.GetApplication(ctx, config.stateId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		// Verify that attributes have expected values
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "name",
+			config.name, response.Name)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "context_root",
+			config.contextRoot, response.ContextRoot)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchBool(resourceType, &config.stateId, "case_sensitive_path",
+			config.caseSensitivePath, response.GetCaseSensitivePath())
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchBool(resourceType, &config.stateId, "enabled",
+			config.enabled, response.GetEnabled())
+		if err != nil {
+			return err
+		}
+		// spa_support_enabled isn't configured, so the default is sent
+		err = acctest.TestAttributesMatchBool(resourceType, &config.stateId, "spa_support_enabled",
+			true, response.SpaSupportEnabled)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckApplicationDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.ApplicationsApi.GetApplication(ctx, applicationId).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Application", applicationId)
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package acctest_test
 
 import (
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
 	"testing"
 
@@ -44,7 +46,14 @@ func TestAccCertificate(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCertificate(resourceName, initialResourceModel),
-				Check:  testAccCheckExpectedCertificateAttributes(initialResourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExpectedCertificateAttributes(initialResourceModel),
+					resource.TestCheckResourceAttr("pingaccess_certificates."+resourceName, "subject_cn", "terraformtest"),
+					resource.TestCheckResourceAttr("pingaccess_certificates."+resourceName, "expires", "2024-05-29T15:59:19Z"),
+					resource.TestCheckResourceAttr("pingaccess_certificates."+resourceName, "key_algorithm", "RSA"),
+					resource.TestCheckResourceAttr("pingaccess_certificates."+resourceName, "key_size", "2048"),
+					resource.TestCheckResourceAttrSet("pingaccess_certificates."+resourceName, "sha256_fingerprint"),
+				),
 			},
 			{
 				// Test updating some fields
@@ -56,21 +65,54 @@ func TestAccCertificate(t *testing.T) {
 				Config:            testAccCertificate(resourceName, updatedResourceModel),
 				ResourceName:      "pingaccess_certificates." + resourceName,
 				ImportStateId:     certificateId,
-				ImportState:       false,
-				ImportStateVerify: false,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// allow_expired only affects validation and isn't stored by PingAccess
+				ImportStateVerifyIgnore: []string{"allow_expired"},
+			},
+			{
+				// Test importing the resource by alias
+				Config:            testAccCertificate(resourceName, updatedResourceModel),
+				ResourceName:      "pingaccess_certificates." + resourceName,
+				ImportStateId:     "alias:" + updatedResourceModel.alias,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// allow_expired only affects validation and isn't stored by PingAccess
+				ImportStateVerifyIgnore: []string{"allow_expired"},
+			},
+			{
+				// Test that switching file_data to PEM encoding of the same certificate doesn't replace it
+				Config: testAccCertificateFileData(resourceName, updatedResourceModel, pemFileData()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExpectedCertificateAttributes(updatedResourceModel),
+					resource.TestCheckResourceAttr("pingaccess_certificates."+resourceName, "subject_cn", "terraformtest"),
+					resource.TestCheckResourceAttr("pingaccess_certificates."+resourceName, "file_data", pemFileData()),
+				),
 			},
 		},
 	})
 }
 
 func testAccCertificate(resourceName string, resourceModel engineListenerResourceModel) string {
+	return testAccCertificateFileData(resourceName, resourceModel, fileData)
+}
+
+// The test certificate as base64-encoded PEM rather than DER
+func pemFileData() string {
+	der, _ := base64.StdEncoding.DecodeString(fileData)
+	return base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func testAccCertificateFileData(resourceName string, resourceModel engineListenerResourceModel, certificateFileData string) string {
 	return fmt.Sprintf(`
 resource "pingaccess_certificates" "%[1]s" {
   alias     = "%[2]s"
   file_data = "%[3]s"
+  # The test certificate has expired
+  allow_expired = true
 }`, resourceName,
 		resourceModel.alias,
-		fileData,
+		certificateFileData,
 	)
 }
 
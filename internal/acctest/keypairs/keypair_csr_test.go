@@ -0,0 +1,160 @@
+package acctest_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/provider"
+)
+
+func TestAccKeyPairCsrDataSource(t *testing.T) {
+	resourceName := "myCsrKeyPair"
+	resourceModel := keyPairResourceModel{
+		alias:      "terraformcsrkeypair",
+		commonName: "terraformcsrtest",
+		validDays:  365,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingaccess": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckKeyPairDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPairCsrDataSource(resourceName, resourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.pingaccess_keypair_csr."+resourceName, "id", "pingaccess_keypair."+resourceName, "id"),
+					resource.TestMatchResourceAttr("data.pingaccess_keypair_csr."+resourceName, "csr", regexp.MustCompile("^-----BEGIN CERTIFICATE REQUEST-----")),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeyPairCsrDataSource(resourceName string, resourceModel keyPairResourceModel) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "pingaccess_keypair_csr" "%[2]s" {
+  keypair_id = pingaccess_keypair.%[2]s.id
+}`, testAccKeyPair(resourceName, resourceModel), resourceName)
+}
+
+func TestAccKeyPairCsrResponse(t *testing.T) {
+	resourceName := "myCsrResponseKeyPair"
+	resourceModel := keyPairResourceModel{
+		alias:      "terraformcsrresponsekeypair",
+		commonName: "terraformcsrresponsetest",
+		validDays:  365,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingaccess": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"tls": {
+				Source: "hashicorp/tls",
+			},
+		},
+		CheckDestroy: testAccCheckKeyPairDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPairCsrResponse(resourceName, resourceModel, 8760),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("pingaccess_keypair_csr_response."+resourceName, "id", "pingaccess_keypair."+resourceName, "id"),
+					resource.TestMatchResourceAttr("pingaccess_keypair_csr_response."+resourceName, "issuer_dn", regexp.MustCompile("CN=terraformcsrresponseca")),
+					resource.TestCheckResourceAttrSet("pingaccess_keypair_csr_response."+resourceName, "serial_number"),
+					testAccCheckKeyPairCsrResponseMatchesServer(resourceName),
+				),
+			},
+			{
+				// Signing the request again replaces the response, and the refreshed serial number must match the new certificate
+				Config: testAccKeyPairCsrResponse(resourceName, resourceModel, 4380),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("pingaccess_keypair_csr_response."+resourceName, "issuer_dn", regexp.MustCompile("CN=terraformcsrresponseca")),
+					testAccCheckKeyPairCsrResponseMatchesServer(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeyPairCsrResponse(resourceName string, resourceModel keyPairResourceModel, validityPeriodHours int64) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "tls_private_key" "%[2]s_ca" {
+  algorithm = "RSA"
+  rsa_bits  = 2048
+}
+
+resource "tls_self_signed_cert" "%[2]s_ca" {
+  private_key_pem       = tls_private_key.%[2]s_ca.private_key_pem
+  is_ca_certificate     = true
+  validity_period_hours = 8760
+  allowed_uses          = ["cert_signing", "crl_signing"]
+  subject {
+    common_name  = "terraformcsrresponseca"
+    organization = "Ping Identity"
+  }
+}
+
+data "pingaccess_keypair_csr" "%[2]s" {
+  keypair_id = pingaccess_keypair.%[2]s.id
+}
+
+resource "tls_locally_signed_cert" "%[2]s" {
+  cert_request_pem      = data.pingaccess_keypair_csr.%[2]s.csr
+  ca_private_key_pem    = tls_private_key.%[2]s_ca.private_key_pem
+  ca_cert_pem           = tls_self_signed_cert.%[2]s_ca.cert_pem
+  validity_period_hours = %[3]d
+  allowed_uses          = ["digital_signature", "key_encipherment", "server_auth"]
+}
+
+resource "pingaccess_keypair_csr_response" "%[2]s" {
+  keypair_id         = pingaccess_keypair.%[2]s.id
+  file_data          = base64encode(tls_locally_signed_cert.%[2]s.cert_pem)
+  chain_certificates = [base64encode(tls_self_signed_cert.%[2]s_ca.cert_pem)]
+}`, testAccKeyPair(resourceName, resourceModel), resourceName, validityPeriodHours)
+}
+
+// Test that the imported response is the Key Pair's current certificate on the PingAccess server
+func testAccCheckKeyPairCsrResponseMatchesServer(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resourceType := "Key Pair"
+		rs, ok := s.RootModule().Resources["pingaccess_keypair_csr_response."+resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: pingaccess_keypair_csr_response.%s", resourceName)
+		}
+		stateId := rs.Primary.Attributes["keypair_id"]
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.KeyPairsApi.GetKeyPair(ctx, stateId).Execute()
+		if err != nil {
+			return err
+		}
+
+		// Verify that attributes have expected values
+		err = acctest.TestAttributesMatchString(resourceType, &stateId, "serial_number",
+			rs.Primary.Attributes["serial_number"], response.GetSerialNumber())
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &stateId, "issuer_dn",
+			rs.Primary.Attributes["issuer_dn"], response.GetIssuerDn())
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
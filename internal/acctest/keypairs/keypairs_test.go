@@ -0,0 +1,155 @@
+package acctest_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/provider"
+)
+
+// Attributes to test with. Add optional properties to test here if desired.
+type keyPairResourceModel struct {
+	alias      string
+	commonName string
+	validDays  int64
+}
+
+func TestAccKeyPair(t *testing.T) {
+	resourceName := "myKeyPair"
+	initialResourceModel := keyPairResourceModel{
+		alias:      "terraformkeypair",
+		commonName: "terraformtest",
+		validDays:  365,
+	}
+	updatedResourceModel := keyPairResourceModel{
+		alias:      "terraformkeypair",
+		commonName: "updatedterraformtest",
+		validDays:  30,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingaccess": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckKeyPairDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test that a generate request rejected by PingAccess is reported
+				Config:      testAccKeyPairInvalidGenerate(resourceName),
+				ExpectError: regexp.MustCompile("An error occurred while creating the Key Pair"),
+			},
+			{
+				Config: testAccKeyPair(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedKeyPairAttributes(resourceName, initialResourceModel),
+			},
+			{
+				// Test replacing the generated key pair
+				Config: testAccKeyPair(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedKeyPairAttributes(resourceName, updatedResourceModel),
+			},
+			{
+				// Test importing the resource by alias
+				Config:                  testAccKeyPair(resourceName, updatedResourceModel),
+				ResourceName:            "pingaccess_keypair." + resourceName,
+				ImportStateId:           "alias:" + updatedResourceModel.alias,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"generate", "status", "csr_pending"},
+			},
+		},
+	})
+}
+
+func testAccKeyPair(resourceName string, resourceModel keyPairResourceModel) string {
+	return fmt.Sprintf(`
+resource "pingaccess_keypair" "%[1]s" {
+  alias = "%[2]s"
+  generate = {
+    common_name   = "%[3]s"
+    organization  = "Ping Identity"
+    country       = "US"
+    key_algorithm = "RSA"
+    key_size      = 2048
+    valid_days    = %[4]d
+    subject_alternative_names = [
+      {
+        name  = "DNSName"
+        value = "%[3]s.example.com"
+      }
+    ]
+  }
+}`, resourceName,
+		resourceModel.alias,
+		resourceModel.commonName,
+		resourceModel.validDays)
+}
+
+func testAccKeyPairInvalidGenerate(resourceName string) string {
+	return fmt.Sprintf(`
+resource "pingaccess_keypair" "%[1]s" {
+  alias = "invalidterraformkeypair"
+  generate = {
+    common_name   = "invalidterraformtest"
+    organization  = "Ping Identity"
+    country       = "US"
+    key_algorithm = "RSA"
+    key_size      = 1234
+    valid_days    = 365
+  }
+}`, resourceName)
+}
+
+// Test that the expected attributes are set on the PingAccess server
+func testAccCheckExpectedKeyPairAttributes(resourceName string, config keyPairResourceModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resourceType := "Key Pair"
+		rs, ok := s.RootModule().Resources["pingaccess_keypair."+resourceName]
+		if !ok {
+			return fmt.Errorf("%s %s not found in state", resourceType, resourceName)
+		}
+		stateId := rs.Primary.ID
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.KeyPairsApi.GetKeyPair(ctx, stateId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		// Verify that attributes have expected values
+		err = acctest.TestAttributesMatchString(resourceType, &stateId, "alias",
+			config.alias, response.Alias)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &stateId, "subject_cn",
+			config.commonName, *response.SubjectCn)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckKeyPairDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "pingaccess_keypair" {
+			continue
+		}
+		_, _, err := testClient.KeyPairsApi.GetKeyPair(ctx, rs.Primary.ID).Execute()
+		if err == nil {
+			return acctest.ExpectedDestroyError("Key Pair", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+package acctest_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/provider"
+)
+
+const ruleId = "10"
+
+const networkRangeClassName = "com.pingidentity.pa.policy.CIDRPolicyInterceptor"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type ruleResourceModel struct {
+	id           int64
+	name         string
+	cidrNotation string
+	negate       bool
+	stateId      string
+}
+
+func TestAccRule(t *testing.T) {
+	resourceName := "myRule"
+	initialResourceModel := ruleResourceModel{
+		id:           10,
+		name:         "example",
+		cidrNotation: "10.0.0.0/8",
+		negate:       false,
+		stateId:      ruleId,
+	}
+	updatedResourceModel := ruleResourceModel{
+		id:           10,
+		name:         "updatedexample",
+		cidrNotation: "192.168.0.0/16",
+		negate:       true,
+		stateId:      ruleId,
+	}
+	// Removing negate from the configuration should reset it to its default
+	cidrOnlyResourceModel := updatedResourceModel
+	cidrOnlyResourceModel.negate = false
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingaccess": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test that configuration fields missing from the Rule descriptor are rejected when planning
+				Config:      testAccRuleInvalidField(resourceName),
+				ExpectError: regexp.MustCompile("Unknown Rule configuration field"),
+			},
+			{
+				Config: testAccRule(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedRuleAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccRule(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedRuleAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource. The imported configuration is the full configuration returned by
+				// PingAccess, so check that it holds the configured values rather than comparing the JSON.
+				Config:           testAccRule(resourceName, updatedResourceModel),
+				ResourceName:     "pingaccess_rule." + resourceName,
+				ImportStateId:    ruleId,
+				ImportState:      true,
+				ImportStateCheck: testAccCheckImportedRule(updatedResourceModel),
+			},
+			{
+				// Test importing the resource by name, keeping the imported state for the next step
+				Config:             testAccRule(resourceName, updatedResourceModel),
+				ResourceName:       "pingaccess_rule." + resourceName,
+				ImportStateId:      "name:" + updatedResourceModel.name,
+				ImportState:        true,
+				ImportStateCheck:   testAccCheckImportedRule(updatedResourceModel),
+				ImportStatePersist: true,
+			},
+			{
+				// Test that applying the configured subset after an import leaves the Rule unchanged
+				Config: testAccRule(resourceName, updatedResourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExpectedRuleAttributes(updatedResourceModel),
+					resource.TestCheckResourceAttr("pingaccess_rule."+resourceName, "configuration",
+						fmt.Sprintf(`{"cidrNotation":"%s","negate":%t}`, updatedResourceModel.cidrNotation, updatedResourceModel.negate)),
+				),
+			},
+			{
+				// Test that removing a field from the configuration resets it on the server
+				Config: testAccRuleCidrOnly(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedRuleAttributes(cidrOnlyResourceModel),
+			},
+		},
+	})
+}
+
+func testAccRule(resourceName string, resourceModel ruleResourceModel) string {
+	return fmt.Sprintf(`
+resource "pingaccess_rule" "%[1]s" {
+  id        = "%[2]d"
+  name      = "%[3]s"
+  classname = "%[4]s"
+  configuration = jsonencode({
+    cidrNotation = "%[5]s"
+    negate       = %[6]t
+  })
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.name,
+		networkRangeClassName,
+		resourceModel.cidrNotation,
+		resourceModel.negate)
+}
+
+func testAccRuleCidrOnly(resourceName string, resourceModel ruleResourceModel) string {
+	return fmt.Sprintf(`
+resource "pingaccess_rule" "%[1]s" {
+  id        = "%[2]d"
+  name      = "%[3]s"
+  classname = "%[4]s"
+  configuration = jsonencode({
+    cidrNotation = "%[5]s"
+  })
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.name,
+		networkRangeClassName,
+		resourceModel.cidrNotation)
+}
+
+func testAccRuleInvalidField(resourceName string) string {
+	return fmt.Sprintf(`
+resource "pingaccess_rule" "%[1]s" {
+  name      = "invalidexample"
+  classname = "%[2]s"
+  configuration = jsonencode({
+    cidrNotation = "10.0.0.0/8"
+    notAField    = true
+  })
+}`, resourceName, networkRangeClassName)
+}
+
+// Test that the expected attributes are set on the PingAccess server
+func testAccCheckExpectedRuleAttributes(config ruleResourceModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resourceType := "Rule"
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.RulesApi.GetRule(ctx, config.stateId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		// Verify that attributes have expected values
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "name",
+			config.name, response.Name)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "classname",
+			networkRangeClassName, response.ClassName)
+		if err != nil {
+			return err
+		}
+		configuration := response.GetConfiguration()
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "configuration.cidrNotation",
+			config.cidrNotation, fmt.Sprint(configuration["cidrNotation"]))
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "configuration.negate",
+			fmt.Sprint(config.negate), fmt.Sprint(configuration["negate"]))
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that an imported Rule has the expected attributes, and a configuration holding the expected values
+func testAccCheckImportedRule(config ruleResourceModel) resource.ImportStateCheckFunc {
+	return func(is []*terraform.InstanceState) error {
+		resourceType := "Rule"
+		if len(is) != 1 {
+			return fmt.Errorf("expected 1 imported Rule, got %d", len(is))
+		}
+		attributes := is[0].Attributes
+		err := acctest.TestAttributesMatchString(resourceType, &config.stateId, "id",
+			config.stateId, attributes["id"])
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "name",
+			config.name, attributes["name"])
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "classname",
+			networkRangeClassName, attributes["classname"])
+		if err != nil {
+			return err
+		}
+		var configuration map[string]interface{}
+		err = json.Unmarshal([]byte(attributes["configuration"]), &configuration)
+		if err != nil {
+			return fmt.Errorf("imported Rule configuration is not JSON: %w", err)
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "configuration.cidrNotation",
+			config.cidrNotation, fmt.Sprint(configuration["cidrNotation"]))
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "configuration.negate",
+			fmt.Sprint(config.negate), fmt.Sprint(configuration["negate"]))
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckRuleDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.RulesApi.GetRule(ctx, ruleId).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Rule", ruleId)
+	}
+	return nil
+}
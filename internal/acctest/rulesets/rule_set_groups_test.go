@@ -0,0 +1,171 @@
+package acctest_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/provider"
+)
+
+const ruleSetGroupId = "20"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type ruleSetGroupResourceModel struct {
+	id              int64
+	name            string
+	reverseRuleSets bool
+	successCriteria string
+	stateId         string
+}
+
+func TestAccRuleSetGroup(t *testing.T) {
+	resourceName := "myRuleSetGroup"
+	initialResourceModel := ruleSetGroupResourceModel{
+		id:              20,
+		name:            "example",
+		reverseRuleSets: false,
+		successCriteria: "SuccessIfAllSucceed",
+		stateId:         ruleSetGroupId,
+	}
+	updatedResourceModel := ruleSetGroupResourceModel{
+		id:              20,
+		name:            "updatedexample",
+		reverseRuleSets: true,
+		successCriteria: "SuccessIfAnyOneSucceeds",
+		stateId:         ruleSetGroupId,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingaccess": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckRuleSetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test that Rule Sets that don't exist are rejected when planning
+				Config:      testAccRuleSetGroupMissingRuleSet(resourceName),
+				ExpectError: regexp.MustCompile("Unknown Rule Set"),
+			},
+			{
+				Config: testAccRuleSetGroup(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedRuleSetGroupAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields, including the order of the Rule Sets
+				Config: testAccRuleSetGroup(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedRuleSetGroupAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccRuleSetGroup(resourceName, updatedResourceModel),
+				ResourceName:      "pingaccess_rule_set_group." + resourceName,
+				ImportStateId:     ruleSetGroupId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Test importing the resource by name
+				Config:            testAccRuleSetGroup(resourceName, updatedResourceModel),
+				ResourceName:      "pingaccess_rule_set_group." + resourceName,
+				ImportStateId:     "name:" + updatedResourceModel.name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRuleSetGroup(resourceName string, resourceModel ruleSetGroupResourceModel) string {
+	ruleSetIds := fmt.Sprintf("[pingaccess_ruleset.%[1]s_first.id, pingaccess_ruleset.%[1]s_second.id]", resourceName)
+	if resourceModel.reverseRuleSets {
+		ruleSetIds = fmt.Sprintf("[pingaccess_ruleset.%[1]s_second.id, pingaccess_ruleset.%[1]s_first.id]", resourceName)
+	}
+	return fmt.Sprintf(`
+%[1]s
+
+resource "pingaccess_ruleset" "%[2]s_first" {
+  id       = "21"
+  name     = "rulesetgrouptestfirst"
+  rule_ids = [pingaccess_rule.%[2]s_first.id]
+}
+
+resource "pingaccess_ruleset" "%[2]s_second" {
+  id       = "22"
+  name     = "rulesetgrouptestsecond"
+  rule_ids = [pingaccess_rule.%[2]s_second.id]
+}
+
+resource "pingaccess_rule_set_group" "%[2]s" {
+  id               = "%[3]d"
+  name             = "%[4]s"
+  rule_set_ids     = %[5]s
+  success_criteria = "%[6]s"
+}`, testAccRuleSetRules(resourceName),
+		resourceName,
+		resourceModel.id,
+		resourceModel.name,
+		ruleSetIds,
+		resourceModel.successCriteria)
+}
+
+func testAccRuleSetGroupMissingRuleSet(resourceName string) string {
+	return fmt.Sprintf(`
+resource "pingaccess_rule_set_group" "%[1]s" {
+  name         = "missingruleset"
+  rule_set_ids = [999999]
+}`, resourceName)
+}
+
+// Test that the expected attributes are set on the PingAccess server
+func testAccCheckExpectedRuleSetGroupAttributes(config ruleSetGroupResourceModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resourceType := "Rule Set Group"
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.RuleSetGroupsApi.GetRuleSetGroup(ctx, config.stateId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		// Verify that attributes have expected values
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "name",
+			config.name, response.Name)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "success_criteria",
+			config.successCriteria, response.GetSuccessCriteria())
+		if err != nil {
+			return err
+		}
+		expectedRuleSetIds := "[21 22]"
+		if config.reverseRuleSets {
+			expectedRuleSetIds = "[22 21]"
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "rule_set_ids",
+			expectedRuleSetIds, fmt.Sprint(response.Policy))
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckRuleSetGroupDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.RuleSetGroupsApi.GetRuleSetGroup(ctx, ruleSetGroupId).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Rule Set Group", ruleSetGroupId)
+	}
+	return nil
+}
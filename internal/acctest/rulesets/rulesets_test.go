@@ -0,0 +1,181 @@
+package acctest_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/provider"
+)
+
+const ruleSetId = "10"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type ruleSetResourceModel struct {
+	id              int64
+	name            string
+	reverseRules    bool
+	successCriteria string
+	stateId         string
+}
+
+func TestAccRuleSet(t *testing.T) {
+	resourceName := "myRuleSet"
+	initialResourceModel := ruleSetResourceModel{
+		id:              10,
+		name:            "example",
+		reverseRules:    false,
+		successCriteria: "SuccessIfAllSucceed",
+		stateId:         ruleSetId,
+	}
+	updatedResourceModel := ruleSetResourceModel{
+		id:              10,
+		name:            "updatedexample",
+		reverseRules:    true,
+		successCriteria: "SuccessIfAnyOneSucceeds",
+		stateId:         ruleSetId,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingaccess": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckRuleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test that Rules that don't exist are rejected when planning
+				Config:      testAccRuleSetMissingRule(resourceName),
+				ExpectError: regexp.MustCompile("Unknown Rule"),
+			},
+			{
+				Config: testAccRuleSet(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedRuleSetAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields, including the order of the Rules
+				Config: testAccRuleSet(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedRuleSetAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource
+				Config:            testAccRuleSet(resourceName, updatedResourceModel),
+				ResourceName:      "pingaccess_ruleset." + resourceName,
+				ImportStateId:     ruleSetId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Test importing the resource by name
+				Config:            testAccRuleSet(resourceName, updatedResourceModel),
+				ResourceName:      "pingaccess_ruleset." + resourceName,
+				ImportStateId:     "name:" + updatedResourceModel.name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// Two Network Range Rules with fixed ids, so that their order can be checked
+func testAccRuleSetRules(resourceName string) string {
+	return fmt.Sprintf(`
+resource "pingaccess_rule" "%[1]s_first" {
+  id        = "11"
+  name      = "rulesettestfirst"
+  classname = "com.pingidentity.pa.policy.CIDRPolicyInterceptor"
+  configuration = jsonencode({
+    cidrNotation = "10.0.0.0/8"
+  })
+}
+
+resource "pingaccess_rule" "%[1]s_second" {
+  id        = "12"
+  name      = "rulesettestsecond"
+  classname = "com.pingidentity.pa.policy.CIDRPolicyInterceptor"
+  configuration = jsonencode({
+    cidrNotation = "192.168.0.0/16"
+  })
+}`, resourceName)
+}
+
+func testAccRuleSet(resourceName string, resourceModel ruleSetResourceModel) string {
+	ruleIds := fmt.Sprintf("[pingaccess_rule.%[1]s_first.id, pingaccess_rule.%[1]s_second.id]", resourceName)
+	if resourceModel.reverseRules {
+		ruleIds = fmt.Sprintf("[pingaccess_rule.%[1]s_second.id, pingaccess_rule.%[1]s_first.id]", resourceName)
+	}
+	return fmt.Sprintf(`
+%[1]s
+
+resource "pingaccess_ruleset" "%[2]s" {
+  id               = "%[3]d"
+  name             = "%[4]s"
+  rule_ids         = %[5]s
+  success_criteria = "%[6]s"
+}`, testAccRuleSetRules(resourceName),
+		resourceName,
+		resourceModel.id,
+		resourceModel.name,
+		ruleIds,
+		resourceModel.successCriteria)
+}
+
+func testAccRuleSetMissingRule(resourceName string) string {
+	return fmt.Sprintf(`
+resource "pingaccess_ruleset" "%[1]s" {
+  name     = "missingrule"
+  rule_ids = [999999]
+}`, resourceName)
+}
+
+// Test that the expected attributes are set on the PingAccess server
+func testAccCheckExpectedRuleSetAttributes(config ruleSetResourceModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resourceType := "Rule Set"
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.RulesetsApi.GetRuleSet(ctx, config.stateId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		// Verify that attributes have expected values
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "name",
+			config.name, response.Name)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "success_criteria",
+			config.successCriteria, response.GetSuccessCriteria())
+		if err != nil {
+			return err
+		}
+		expectedRuleIds := "[11 12]"
+		if config.reverseRules {
+			expectedRuleIds = "[12 11]"
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "rule_ids",
+			expectedRuleIds, fmt.Sprint(response.Policy))
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckRuleSetDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.RulesetsApi.GetRuleSet(ctx, ruleSetId).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Rule Set", ruleSetId)
+	}
+	return nil
+}
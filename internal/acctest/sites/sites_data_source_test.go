@@ -0,0 +1,52 @@
+package acctest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/provider"
+)
+
+func TestAccSiteDataSource(t *testing.T) {
+	resourceName := "mySite"
+	resourceModel := siteResourceModel{
+		id:      2,
+		name:    "example",
+		targets: []string{"localhost:80", "localhost:443"},
+		stateId: "2",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingaccess": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteDataSource(resourceName, resourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.pingaccess_site.byName", "id", "pingaccess_sites."+resourceName, "id"),
+					resource.TestCheckResourceAttrPair("data.pingaccess_site.byId", "name", "pingaccess_sites."+resourceName, "name"),
+					resource.TestCheckResourceAttr("data.pingaccess_site.byId", "targets.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSiteDataSource(resourceName string, resourceModel siteResourceModel) string {
+	return fmt.Sprintf(`%[1]s
+
+data "pingaccess_site" "byName" {
+  name = pingaccess_sites.%[2]s.name
+}
+
+data "pingaccess_site" "byId" {
+  id = pingaccess_sites.%[2]s.id
+}`, testAccSite(resourceName, resourceModel), resourceName)
+}
@@ -0,0 +1,61 @@
+package acctest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/provider"
+)
+
+func TestAccSitesDataSource(t *testing.T) {
+	resourceName := "mySite"
+	resourceModel := siteResourceModel{
+		id:      2,
+		name:    "example",
+		targets: []string{"localhost:80", "localhost:443"},
+		stateId: "2",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingaccess": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Test a data source that doesn't depend on any resource, so it is read while planning
+				Config: testAccAllSitesDataSource(),
+				Check:  resource.TestCheckResourceAttrSet("data.pingaccess_sites.all", "items.#"),
+			},
+			{
+				Config: testAccSitesDataSource(resourceName, resourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pingaccess_sites.byName", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.pingaccess_sites.byName", "items.0.id", "pingaccess_sites."+resourceName, "id"),
+					resource.TestCheckResourceAttr("data.pingaccess_sites.byName", "items.0.targets.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSitesDataSource(resourceName string, resourceModel siteResourceModel) string {
+	return fmt.Sprintf(`%[1]s
+
+data "pingaccess_sites" "byName" {
+  name     = pingaccess_sites.%[2]s.name
+  sort_key = "name"
+  order    = "ASC"
+}`, testAccSite(resourceName, resourceModel), resourceName)
+}
+
+func testAccAllSitesDataSource() string {
+	return `
+data "pingaccess_sites" "all" {
+}`
+}
@@ -53,6 +53,19 @@ func TestAccSite(t *testing.T) {
 				Config: testAccSite(resourceName, updatedResourceModel),
 				Check:  testAccCheckExpectedSiteAttributes(updatedResourceModel),
 			},
+			{
+				// Test that the resource is recreated after being deleted outside of Terraform
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.SitesApi.DeleteSite(ctx, siteId).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete Site %s: %v", siteId, err)
+					}
+				},
+				Config: testAccSite(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedSiteAttributes(updatedResourceModel),
+			},
 			{
 				// Test importing the resource
 				Config:                  testAccSite(resourceName, updatedResourceModel),
@@ -62,6 +75,15 @@ func TestAccSite(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"items"},
 			},
+			{
+				// Test importing the resource by name
+				Config:                  testAccSite(resourceName, updatedResourceModel),
+				ResourceName:            "pingaccess_sites." + resourceName,
+				ImportStateId:           "name:" + updatedResourceModel.name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"items"},
+			},
 		},
 	})
 }
@@ -0,0 +1,33 @@
+package acctest_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/provider"
+)
+
+// Look up one of the system trusted certificate groups that every PingAccess server has
+func TestAccTrustedCertificateGroupDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingaccess": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "pingaccess_trusted_certificate_group" "trustAny" {
+  name = "Trust Any"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pingaccess_trusted_certificate_group.trustAny", "id"),
+					resource.TestCheckResourceAttr("data.pingaccess_trusted_certificate_group.trustAny", "system_group", "true"),
+				),
+			},
+		},
+	})
+}
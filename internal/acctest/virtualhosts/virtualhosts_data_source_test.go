@@ -0,0 +1,56 @@
+package acctest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/provider"
+)
+
+func TestAccVirtualHostDataSource(t *testing.T) {
+	resourceName := "myVirtualHost"
+	resourceModel := virtualhostResourceModel{
+		id:                        3,
+		agentResourceCacheTTL:     0,
+		host:                      "test",
+		keyPairId:                 3,
+		port:                      1234,
+		trustedCertificateGroupId: 0,
+		stateId:                   "3",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingaccess": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckVirtualHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualHostDataSource(resourceName, resourceModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.pingaccess_virtualhost.byHost", "id", "pingaccess_virtualhosts."+resourceName, "id"),
+					resource.TestCheckResourceAttrPair("data.pingaccess_virtualhost.byId", "host", "pingaccess_virtualhosts."+resourceName, "host"),
+					resource.TestCheckResourceAttrPair("data.pingaccess_virtualhost.byId", "port", "pingaccess_virtualhosts."+resourceName, "port"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVirtualHostDataSource(resourceName string, resourceModel virtualhostResourceModel) string {
+	return fmt.Sprintf(`%[1]s
+
+data "pingaccess_virtualhost" "byHost" {
+  host = pingaccess_virtualhosts.%[2]s.host
+  port = pingaccess_virtualhosts.%[2]s.port
+}
+
+data "pingaccess_virtualhost" "byId" {
+  id = pingaccess_virtualhosts.%[2]s.id
+}`, testAccVirtualHost(resourceName, resourceModel), resourceName)
+}
@@ -2,6 +2,7 @@ package acctest_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -71,6 +72,23 @@ func TestAccVirtualHost(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"items"},
 			},
+			{
+				// Test importing the resource by host and port
+				Config:                  testAccVirtualHost(resourceName, updatedResourceModel),
+				ResourceName:            "pingaccess_virtualhosts." + resourceName,
+				ImportStateId:           fmt.Sprintf("name:%s:%d", updatedResourceModel.host, updatedResourceModel.port),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"items"},
+			},
+			{
+				// Test that an import name without a numeric port is rejected
+				Config:        testAccVirtualHost(resourceName, updatedResourceModel),
+				ResourceName:  "pingaccess_virtualhosts." + resourceName,
+				ImportStateId: "name:" + updatedResourceModel.host + ":notaport",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`port "notaport" of`),
+			},
 		},
 	})
 }
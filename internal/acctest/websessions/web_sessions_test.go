@@ -0,0 +1,156 @@
+package acctest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingaccess/internal/provider"
+)
+
+const webSessionId = "10"
+
+// Attributes to test with. Add optional properties to test here if desired.
+type webSessionResourceModel struct {
+	id                   int64
+	name                 string
+	audience             string
+	clientSecret         string
+	sameSite             string
+	idleTimeoutInMinutes int64
+	stateId              string
+}
+
+func TestAccWebSession(t *testing.T) {
+	resourceName := "myWebSession"
+	initialResourceModel := webSessionResourceModel{
+		id:                   10,
+		name:                 "example",
+		audience:             "example",
+		clientSecret:         "2FederateM0re",
+		sameSite:             "Lax",
+		idleTimeoutInMinutes: 60,
+		stateId:              webSessionId,
+	}
+	updatedResourceModel := webSessionResourceModel{
+		id:                   10,
+		name:                 "updatedexample",
+		audience:             "updatedexample",
+		clientSecret:         "2FederateM0reUpdated",
+		sameSite:             "None",
+		idleTimeoutInMinutes: 30,
+		stateId:              webSessionId,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingaccess": providerserver.NewProtocol6WithError(provider.New()),
+		},
+		CheckDestroy: testAccCheckWebSessionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebSession(resourceName, initialResourceModel),
+				Check:  testAccCheckExpectedWebSessionAttributes(initialResourceModel),
+			},
+			{
+				// Test updating some fields
+				Config: testAccWebSession(resourceName, updatedResourceModel),
+				Check:  testAccCheckExpectedWebSessionAttributes(updatedResourceModel),
+			},
+			{
+				// Test importing the resource. The client secret isn't returned by PingAccess.
+				Config:                  testAccWebSession(resourceName, updatedResourceModel),
+				ResourceName:            "pingaccess_web_session." + resourceName,
+				ImportStateId:           webSessionId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_credentials.client_secret"},
+			},
+			{
+				// Test importing the resource by name
+				Config:                  testAccWebSession(resourceName, updatedResourceModel),
+				ResourceName:            "pingaccess_web_session." + resourceName,
+				ImportStateId:           "name:" + updatedResourceModel.name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_credentials.client_secret"},
+			},
+		},
+	})
+}
+
+func testAccWebSession(resourceName string, resourceModel webSessionResourceModel) string {
+	return fmt.Sprintf(`
+resource "pingaccess_web_session" "%[1]s" {
+  id       = "%[2]d"
+  name     = "%[3]s"
+  audience = "%[4]s"
+  client_credentials = {
+    client_id = "pingaccess"
+    client_secret = {
+      value = "%[5]s"
+    }
+  }
+  same_site               = "%[6]s"
+  idle_timeout_in_minutes = %[7]d
+}`, resourceName,
+		resourceModel.id,
+		resourceModel.name,
+		resourceModel.audience,
+		resourceModel.clientSecret,
+		resourceModel.sameSite,
+		resourceModel.idleTimeoutInMinutes)
+}
+
+// Test that the expected attributes are set on the PingAccess server
+func testAccCheckExpectedWebSessionAttributes(config webSessionResourceModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resourceType := "Web Session"
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.WebSessionsApi.GetWebSession(ctx, config.stateId).Execute()
+
+		if err != nil {
+			return err
+		}
+
+		// Verify that attributes have expected values
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "name",
+			config.name, response.Name)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "audience",
+			config.audience, response.Audience)
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString(resourceType, &config.stateId, "same_site",
+			config.sameSite, response.GetSameSite())
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchInt(resourceType, &config.stateId, "idle_timeout_in_minutes",
+			config.idleTimeoutInMinutes, response.GetIdleTimeoutInMinutes())
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func testAccCheckWebSessionDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.WebSessionsApi.GetWebSession(ctx, webSessionId).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Web Session", webSessionId)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+	"golang.org/x/oauth2"
+)
+
+// Extra headers must reach the OAuth token endpoint as well as the admin API
+func TestHeaderTransportAddsHeadersToTokenAndApiRequests(t *testing.T) {
+	var tokenHeader, apiHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			tokenHeader = r.Header.Get("X-Tenant")
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer"}`))
+			return
+		}
+		apiHeader = r.Header.Get("X-Tenant")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	providerConfig := internaltypes.ProviderConfiguration{
+		OAuthTokenUrl:     server.URL + "/token",
+		OAuthClientId:     "client",
+		OAuthClientSecret: "secret",
+	}
+	headerTr := newHeaderTransport(http.DefaultTransport, map[string]string{"X-Tenant": "example"})
+	tokenSource, err := buildOAuthTokenSource(providerConfig, &http.Client{Transport: headerTr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpClient := &http.Client{Transport: &oauth2.Transport{Source: tokenSource, Base: headerTr}}
+	resp, err := httpClient.Get(server.URL + "/pa-admin-api/v3/version")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if tokenHeader != "example" {
+		t.Errorf("expected the token request to include the extra header, got %q", tokenHeader)
+	}
+	if apiHeader != "example" {
+		t.Errorf("expected the admin API request to include the extra header, got %q", apiHeader)
+	}
+}
+
+func TestParseExtraHeaders(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected map[string]string
+		valid    bool
+	}{
+		{"X-Tenant=example", map[string]string{"X-Tenant": "example"}, true},
+		{" X-Tenant = example , X-Env=test,", map[string]string{"X-Tenant": "example", "X-Env": "test"}, true},
+		{"X-Token=a=b", map[string]string{"X-Token": "a=b"}, true},
+		{"X-Tenant", nil, false},
+		{"=example", nil, false},
+	}
+	for _, test := range tests {
+		headers, err := parseExtraHeaders(test.value)
+		if (err == nil) != test.valid {
+			t.Errorf("%q: unexpected error result %v", test.value, err)
+			continue
+		}
+		if len(headers) != len(test.expected) {
+			t.Errorf("%q: expected %v, got %v", test.value, test.expected, headers)
+			continue
+		}
+		for name, value := range test.expected {
+			if headers[name] != value {
+				t.Errorf("%q: expected %s=%s, got %q", test.value, name, value, headers[name])
+			}
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// Build the OAuth token source used to authenticate admin API requests, if OAuth is configured.
+// The returned token source caches tokens and refreshes them when they expire, and is shared by
+// every resource through the provider's HTTP transport.
+func buildOAuthTokenSource(providerConfig internaltypes.ProviderConfiguration, httpClient *http.Client) (oauth2.TokenSource, error) {
+	useAccessToken := providerConfig.OAuthAccessToken != ""
+	useClientCredentials := providerConfig.OAuthTokenUrl != "" || providerConfig.OAuthClientId != "" || providerConfig.OAuthClientSecret != ""
+	if useAccessToken && useClientCredentials {
+		return nil, errors.New("oauth access_token cannot be combined with token_url, client_id or client_secret")
+	}
+
+	if useAccessToken {
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: providerConfig.OAuthAccessToken}), nil
+	}
+
+	if useClientCredentials {
+		if providerConfig.OAuthTokenUrl == "" || providerConfig.OAuthClientId == "" || providerConfig.OAuthClientSecret == "" {
+			return nil, errors.New("oauth token_url, client_id and client_secret must all be set to use the client credentials grant")
+		}
+		clientCredentialsConfig := clientcredentials.Config{
+			ClientID:     providerConfig.OAuthClientId,
+			ClientSecret: providerConfig.OAuthClientSecret,
+			TokenURL:     providerConfig.OAuthTokenUrl,
+			Scopes:       providerConfig.OAuthScopes,
+		}
+		// The token source outlives the Configure request, so it can't use the request context.
+		// The HTTP client ensures token requests use the same TLS settings as the admin API.
+		tokenCtx := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
+		return clientCredentialsConfig.TokenSource(tokenCtx), nil
+	}
+
+	return nil, nil
+}
@@ -2,11 +2,14 @@ package provider
 
 import (
 	"context"
-	"crypto/tls"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -14,18 +17,27 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	client "github.com/pingidentity/pingaccess-go-client"
 	accessTokenValidator "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/accesstokenvalidators"
+	acmeAccounts "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/acmeaccounts"
+	acmeCertificateRequests "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/acmecertificaterequests"
 	acmeServers "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/acmeservers"
+	applicationResources "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/applicationresources"
+	applications "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/applications"
 	authnReqList "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/authnreqlists"
 	certificates "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/certificates"
 	engineListener "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/enginelisteners"
 	highAvailabilityProfiles "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/highavailabilityprofiles"
 	hsmProvider "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/hsmproviders"
+	keyPairs "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/keypairs"
 	proxies "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/proxies"
+	rules "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/rules"
+	rulesets "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/rulesets"
 	sites "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/sites"
 	thirdPartyService "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/thirdpartyservices"
 	trustedCertificateGroup "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/trustedcertificategroups"
 	virtualHost "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/virtualhosts"
+	webSessions "github.com/pingidentity/terraform-provider-pingaccess/internal/resource/websessions"
 	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+	"golang.org/x/oauth2"
 )
 
 // Ensure the implementation satisfies the expected interfacesß
@@ -33,6 +45,12 @@ var (
 	_ provider.Provider = &pingaccessProvider{}
 )
 
+// Default base path of the admin API
+const defaultAdminApiPath = "/pa-admin-api/v3"
+
+// Default time to wait for engines to pick up configuration changes
+const defaultReplicationTimeout = 5 * time.Minute
+
 // New is a helper function to simplify provider server and testing implementation.
 func New() provider.Provider {
 	return &pingaccessProvider{}
@@ -40,9 +58,41 @@ func New() provider.Provider {
 
 // PingAccess ProviderModel maps provider schema data to a Go type.
 type pingaccessProviderModel struct {
-	HttpsHost types.String `tfsdk:"https_host"`
-	Username  types.String `tfsdk:"username"`
-	Password  types.String `tfsdk:"password"`
+	HttpsHost                    types.String                  `tfsdk:"https_host"`
+	Username                     types.String                  `tfsdk:"username"`
+	Password                     types.String                  `tfsdk:"password"`
+	CaCertificatePem             types.String                  `tfsdk:"ca_certificate_pem"`
+	ServerCertificateFingerprint types.String                  `tfsdk:"server_certificate_fingerprint"`
+	TlsServerName                types.String                  `tfsdk:"tls_server_name"`
+	InsecureTrustAll             types.Bool                    `tfsdk:"insecure_trust_all"`
+	ClientCertificatePem         types.String                  `tfsdk:"client_certificate_pem"`
+	ClientPrivateKeyPem          types.String                  `tfsdk:"client_private_key_pem"`
+	ClientPkcs12                 types.String                  `tfsdk:"client_pkcs12"`
+	ClientPkcs12Password         types.String                  `tfsdk:"client_pkcs12_password"`
+	RequestTimeout               types.String                  `tfsdk:"request_timeout"`
+	MaxRetries                   types.Int64                   `tfsdk:"max_retries"`
+	RetryMinBackoff              types.String                  `tfsdk:"retry_min_backoff"`
+	RetryMaxBackoff              types.String                  `tfsdk:"retry_max_backoff"`
+	RetryableStatusCodes         types.List                    `tfsdk:"retryable_status_codes"`
+	RetryNonIdempotentRequests   types.Bool                    `tfsdk:"retry_non_idempotent_requests"`
+	MaxConcurrentWrites          types.Int64                   `tfsdk:"max_concurrent_writes"`
+	MaxRequestsPerSecond         types.Float64                 `tfsdk:"max_requests_per_second"`
+	WaitForReplication           types.Bool                    `tfsdk:"wait_for_replication"`
+	ReplicationTimeout           types.String                  `tfsdk:"replication_timeout"`
+	HttpProxy                    types.String                  `tfsdk:"http_proxy"`
+	NoProxy                      types.String                  `tfsdk:"no_proxy"`
+	ExtraHeaders                 types.Map                     `tfsdk:"extra_headers"`
+	AdminApiPath                 types.String                  `tfsdk:"admin_api_path"`
+	OAuth                        *pingaccessProviderOAuthModel `tfsdk:"oauth"`
+}
+
+// OAuth settings for the provider
+type pingaccessProviderOAuthModel struct {
+	TokenUrl     types.String `tfsdk:"token_url"`
+	ClientId     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Scopes       types.List   `tfsdk:"scopes"`
+	AccessToken  types.String `tfsdk:"access_token"`
 }
 
 // pingaccessProvider is the provider implementation.
@@ -62,15 +112,138 @@ func (p *pingaccessProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				MarkdownDescription: "URI for PingAccess HTTPS port",
 				Optional:            true,
 			},
+			"admin_api_path": schema.StringAttribute{
+				MarkdownDescription: "Base path of the admin API on the PingAccess server. Defaults to `/pa-admin-api/v3`. Can also be set with the `PINGACCESS_PROVIDER_ADMIN_API_PATH` environment variable.",
+				Optional:            true,
+			},
 			"username": schema.StringAttribute{
-				MarkdownDescription: "Username for PingAccess Admin user",
+				MarkdownDescription: "Username for PingAccess Admin user. Not required when a client certificate or OAuth is configured.",
 				Optional:            true,
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "Password for PingAccess Admin user",
+				MarkdownDescription: "Password for PingAccess Admin user. Not required when a client certificate or OAuth is configured.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"ca_certificate_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CA certificate bundle used to verify the PingAccess admin API certificate, or a path to a file containing one. Defaults to the system trust store. Can also be set with the `PINGACCESS_PROVIDER_CA_CERTIFICATE_PEM` environment variable.",
+				Optional:            true,
+			},
+			"server_certificate_fingerprint": schema.StringAttribute{
+				MarkdownDescription: "Hex-encoded SHA-256 fingerprint of the PingAccess admin API certificate. When set, the server certificate must match this fingerprint. Can also be set with the `PINGACCESS_PROVIDER_SERVER_CERTIFICATE_FINGERPRINT` environment variable.",
+				Optional:            true,
+			},
+			"tls_server_name": schema.StringAttribute{
+				MarkdownDescription: "Server name used to verify the PingAccess admin API certificate, if it differs from the host in `https_host`. Can also be set with the `PINGACCESS_PROVIDER_TLS_SERVER_NAME` environment variable.",
+				Optional:            true,
+			},
+			"insecure_trust_all": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to skip verification of the PingAccess admin API certificate. This is insecure and should only be used for testing. Defaults to false. Can also be set with the `PINGACCESS_PROVIDER_INSECURE_TRUST_ALL` environment variable.",
+				Optional:            true,
+			},
+			"client_certificate_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded client certificate presented for mutual TLS, or a path to a file containing one. Must be set with `client_private_key_pem`. Can also be set with the `PINGACCESS_PROVIDER_CLIENT_CERTIFICATE_PEM` environment variable.",
+				Optional:            true,
+			},
+			"client_private_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded private key for `client_certificate_pem`, or a path to a file containing one. Can also be set with the `PINGACCESS_PROVIDER_CLIENT_PRIVATE_KEY_PEM` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"client_pkcs12": schema.StringAttribute{
+				MarkdownDescription: "Base64-encoded PKCS#12 keystore containing the client certificate and key presented for mutual TLS, or a path to a PKCS#12 file. Cannot be combined with `client_certificate_pem`. Can also be set with the `PINGACCESS_PROVIDER_CLIENT_PKCS12` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"client_pkcs12_password": schema.StringAttribute{
+				MarkdownDescription: "Password for `client_pkcs12`. Can also be set with the `PINGACCESS_PROVIDER_CLIENT_PKCS12_PASSWORD` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait for a single admin API request, as a duration such as `60s`. A request that times out may be retried. Defaults to `60s`. Set to `0s` to disable. Resource operations are also bounded by their `timeouts` block. Can also be set with the `PINGACCESS_PROVIDER_REQUEST_TIMEOUT` environment variable.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of times a failed admin API request is retried. Defaults to 4. Set to 0 to disable retries. Can also be set with the `PINGACCESS_PROVIDER_MAX_RETRIES` environment variable.",
+				Optional:            true,
+			},
+			"retry_min_backoff": schema.StringAttribute{
+				MarkdownDescription: "Delay before the first retry, as a duration such as `1s`. The delay doubles on each retry, with jitter. Defaults to `1s`. Can also be set with the `PINGACCESS_PROVIDER_RETRY_MIN_BACKOFF` environment variable.",
+				Optional:            true,
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				MarkdownDescription: "Maximum delay between retries, as a duration such as `30s`. Defaults to `30s`. Can also be set with the `PINGACCESS_PROVIDER_RETRY_MAX_BACKOFF` environment variable.",
+				Optional:            true,
+			},
+			"retryable_status_codes": schema.ListAttribute{
+				MarkdownDescription: "HTTP status codes that cause a request to be retried. Connection errors are always retried. Defaults to `[429, 500, 502, 503, 504]`. Can also be set as a comma-separated list with the `PINGACCESS_PROVIDER_RETRYABLE_STATUS_CODES` environment variable.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"retry_non_idempotent_requests": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to also retry requests that are not idempotent, such as the POST requests that create objects. Defaults to false. Can also be set with the `PINGACCESS_PROVIDER_RETRY_NON_IDEMPOTENT_REQUESTS` environment variable.",
+				Optional:            true,
+			},
+			"max_concurrent_writes": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of add, update and delete requests sent to the admin API at the same time, independent of Terraform's `-parallelism`. Reads are not limited. Set to 1 to serialize changes and avoid optimistic-locking conflicts. Defaults to 0, which means no limit. Can also be set with the `PINGACCESS_PROVIDER_MAX_CONCURRENT_WRITES` environment variable.",
+				Optional:            true,
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of admin API requests sent per second, across all resources. Defaults to 0, which means no limit. Can also be set with the `PINGACCESS_PROVIDER_MAX_REQUESTS_PER_SECOND` environment variable.",
+				Optional:            true,
+			},
+			"wait_for_replication": schema.BoolAttribute{
+				MarkdownDescription: "Set to true to wait after each add, update and delete until every engine and replica admin has picked up the configuration change. Nodes that don't catch up before `replication_timeout` are reported as a warning. Defaults to false. Can also be set with the `PINGACCESS_PROVIDER_WAIT_FOR_REPLICATION` environment variable.",
+				Optional:            true,
+			},
+			"replication_timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait for configuration replication when `wait_for_replication` is true, as a duration such as `5m`. Defaults to `5m`. Can also be set with the `PINGACCESS_PROVIDER_REPLICATION_TIMEOUT` environment variable.",
+				Optional:            true,
+			},
+			"http_proxy": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy used to reach the admin API and the OAuth token endpoint, such as `http://proxy.example.com:8080`. Defaults to the standard `HTTPS_PROXY` and `HTTP_PROXY` environment variables. Can also be set with the `PINGACCESS_PROVIDER_HTTP_PROXY` environment variable.",
+				Optional:            true,
+			},
+			"no_proxy": schema.StringAttribute{
+				MarkdownDescription: "Comma-separated list of hosts, domains and CIDR ranges that bypass the proxy. Defaults to the standard `NO_PROXY` environment variable. Can also be set with the `PINGACCESS_PROVIDER_NO_PROXY` environment variable.",
+				Optional:            true,
+			},
+			"extra_headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers sent with every admin API and OAuth token request, such as a tenant header required by an API gateway. Can also be set as a comma-separated list of `name=value` pairs with the `PINGACCESS_PROVIDER_EXTRA_HEADERS` environment variable.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"oauth": schema.SingleNestedBlock{
+				MarkdownDescription: "Authenticate to the PingAccess admin API with OAuth 2.0 bearer tokens instead of basic authentication. Either `access_token` or all of `token_url`, `client_id` and `client_secret` must be set.",
+				Attributes: map[string]schema.Attribute{
+					"token_url": schema.StringAttribute{
+						MarkdownDescription: "Token endpoint used to obtain access tokens with the client credentials grant. Can also be set with the `PINGACCESS_PROVIDER_OAUTH_TOKEN_URL` environment variable.",
+						Optional:            true,
+					},
+					"client_id": schema.StringAttribute{
+						MarkdownDescription: "Client ID used with the client credentials grant. Can also be set with the `PINGACCESS_PROVIDER_OAUTH_CLIENT_ID` environment variable.",
+						Optional:            true,
+					},
+					"client_secret": schema.StringAttribute{
+						MarkdownDescription: "Client secret used with the client credentials grant. Can also be set with the `PINGACCESS_PROVIDER_OAUTH_CLIENT_SECRET` environment variable.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"scopes": schema.ListAttribute{
+						MarkdownDescription: "Scopes requested with the client credentials grant. Can also be set as a comma-separated list with the `PINGACCESS_PROVIDER_OAUTH_SCOPES` environment variable.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"access_token": schema.StringAttribute{
+						MarkdownDescription: "Static access token used instead of the client credentials grant. Can also be set with the `PINGACCESS_PROVIDER_OAUTH_ACCESS_TOKEN` environment variable.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -105,6 +278,140 @@ func (p *pingaccessProvider) Configure(ctx context.Context, req provider.Configu
 		}
 	}
 
+	// Optional TLS settings for connecting to the admin API
+	caCertificatePem := getOptionalString(config.CaCertificatePem, "ca_certificate_pem", "PINGACCESS_PROVIDER_CA_CERTIFICATE_PEM", &resp.Diagnostics)
+	serverCertificateFingerprint := getOptionalString(config.ServerCertificateFingerprint, "server_certificate_fingerprint", "PINGACCESS_PROVIDER_SERVER_CERTIFICATE_FINGERPRINT", &resp.Diagnostics)
+	tlsServerName := getOptionalString(config.TlsServerName, "tls_server_name", "PINGACCESS_PROVIDER_TLS_SERVER_NAME", &resp.Diagnostics)
+	insecureTrustAll := getOptionalBool(config.InsecureTrustAll, "insecure_trust_all", "PINGACCESS_PROVIDER_INSECURE_TRUST_ALL", &resp.Diagnostics)
+
+	// Optional client certificate for mutual TLS authentication
+	clientCertificatePem := getOptionalString(config.ClientCertificatePem, "client_certificate_pem", "PINGACCESS_PROVIDER_CLIENT_CERTIFICATE_PEM", &resp.Diagnostics)
+	clientPrivateKeyPem := getOptionalString(config.ClientPrivateKeyPem, "client_private_key_pem", "PINGACCESS_PROVIDER_CLIENT_PRIVATE_KEY_PEM", &resp.Diagnostics)
+	clientPkcs12 := getOptionalString(config.ClientPkcs12, "client_pkcs12", "PINGACCESS_PROVIDER_CLIENT_PKCS12", &resp.Diagnostics)
+	clientPkcs12Password := getOptionalString(config.ClientPkcs12Password, "client_pkcs12_password", "PINGACCESS_PROVIDER_CLIENT_PKCS12_PASSWORD", &resp.Diagnostics)
+
+	// Optional timeout and retry settings for transient admin API failures
+	requestTimeout := getOptionalDuration(config.RequestTimeout, "request_timeout", "PINGACCESS_PROVIDER_REQUEST_TIMEOUT", defaultRequestTimeout, &resp.Diagnostics)
+	maxRetries := getOptionalInt64(config.MaxRetries, "max_retries", "PINGACCESS_PROVIDER_MAX_RETRIES", defaultMaxRetries, &resp.Diagnostics)
+	retryMinBackoff := getOptionalDuration(config.RetryMinBackoff, "retry_min_backoff", "PINGACCESS_PROVIDER_RETRY_MIN_BACKOFF", defaultRetryMinBackoff, &resp.Diagnostics)
+	retryMaxBackoff := getOptionalDuration(config.RetryMaxBackoff, "retry_max_backoff", "PINGACCESS_PROVIDER_RETRY_MAX_BACKOFF", defaultRetryMaxBackoff, &resp.Diagnostics)
+	retryNonIdempotentRequests := getOptionalBool(config.RetryNonIdempotentRequests, "retry_non_idempotent_requests", "PINGACCESS_PROVIDER_RETRY_NON_IDEMPOTENT_REQUESTS", &resp.Diagnostics)
+	retryableStatusCodes := defaultRetryableStatusCodes
+	if config.RetryableStatusCodes.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unable to connect to the PingAccess Server",
+			"Cannot use unknown value as retryable_status_codes",
+		)
+	} else if config.RetryableStatusCodes.IsNull() {
+		if envCodes := os.Getenv("PINGACCESS_PROVIDER_RETRYABLE_STATUS_CODES"); envCodes != "" {
+			retryableStatusCodes = nil
+			for _, envCode := range strings.Split(envCodes, ",") {
+				code, err := strconv.ParseInt(strings.TrimSpace(envCode), 10, 64)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Invalid value for retryable_status_codes",
+						"The PINGACCESS_PROVIDER_RETRYABLE_STATUS_CODES environment variable must be a comma-separated list of integers: "+err.Error(),
+					)
+					break
+				}
+				retryableStatusCodes = append(retryableStatusCodes, code)
+			}
+		}
+	} else {
+		retryableStatusCodes = nil
+		resp.Diagnostics.Append(config.RetryableStatusCodes.ElementsAs(ctx, &retryableStatusCodes, false)...)
+	}
+	// Optional replication settings
+	waitForReplication := getOptionalBool(config.WaitForReplication, "wait_for_replication", "PINGACCESS_PROVIDER_WAIT_FOR_REPLICATION", &resp.Diagnostics)
+	replicationTimeout := getOptionalDuration(config.ReplicationTimeout, "replication_timeout", "PINGACCESS_PROVIDER_REPLICATION_TIMEOUT", defaultReplicationTimeout, &resp.Diagnostics)
+	if replicationTimeout < 0 {
+		resp.Diagnostics.AddError("Invalid value for replication_timeout", "replication_timeout cannot be negative")
+	}
+
+	// Optional admin API path
+	adminApiPath := getOptionalString(config.AdminApiPath, "admin_api_path", "PINGACCESS_PROVIDER_ADMIN_API_PATH", &resp.Diagnostics)
+	if adminApiPath == "" {
+		adminApiPath = defaultAdminApiPath
+	} else if !strings.HasPrefix(adminApiPath, "/") {
+		adminApiPath = "/" + adminApiPath
+	}
+	adminApiPath = strings.TrimSuffix(adminApiPath, "/")
+
+	// Optional proxy and header settings
+	httpProxy := getOptionalString(config.HttpProxy, "http_proxy", "PINGACCESS_PROVIDER_HTTP_PROXY", &resp.Diagnostics)
+	noProxy := getOptionalString(config.NoProxy, "no_proxy", "PINGACCESS_PROVIDER_NO_PROXY", &resp.Diagnostics)
+	extraHeaders := map[string]string{}
+	if config.ExtraHeaders.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unable to connect to the PingAccess Server",
+			"Cannot use unknown value as extra_headers",
+		)
+	} else if config.ExtraHeaders.IsNull() {
+		if envHeaders := os.Getenv("PINGACCESS_PROVIDER_EXTRA_HEADERS"); envHeaders != "" {
+			var err error
+			extraHeaders, err = parseExtraHeaders(envHeaders)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Invalid value for extra_headers",
+					"The PINGACCESS_PROVIDER_EXTRA_HEADERS environment variable must be a comma-separated list of name=value pairs: "+err.Error(),
+				)
+			}
+		}
+	} else {
+		resp.Diagnostics.Append(config.ExtraHeaders.ElementsAs(ctx, &extraHeaders, false)...)
+	}
+
+	// Optional throttling settings
+	maxConcurrentWrites := getOptionalInt64(config.MaxConcurrentWrites, "max_concurrent_writes", "PINGACCESS_PROVIDER_MAX_CONCURRENT_WRITES", 0, &resp.Diagnostics)
+	maxRequestsPerSecond := getOptionalFloat64(config.MaxRequestsPerSecond, "max_requests_per_second", "PINGACCESS_PROVIDER_MAX_REQUESTS_PER_SECOND", 0, &resp.Diagnostics)
+	if maxConcurrentWrites < 0 {
+		resp.Diagnostics.AddError("Invalid value for max_concurrent_writes", "max_concurrent_writes cannot be negative")
+	}
+	if maxRequestsPerSecond < 0 {
+		resp.Diagnostics.AddError("Invalid value for max_requests_per_second", "max_requests_per_second cannot be negative")
+	}
+	if requestTimeout < 0 {
+		resp.Diagnostics.AddError("Invalid value for request_timeout", "request_timeout cannot be negative")
+	}
+	if maxRetries < 0 {
+		resp.Diagnostics.AddError("Invalid value for max_retries", "max_retries cannot be negative")
+	}
+	if retryMinBackoff > retryMaxBackoff {
+		resp.Diagnostics.AddError("Invalid value for retry_min_backoff", "retry_min_backoff cannot be greater than retry_max_backoff")
+	}
+
+	// Optional OAuth settings, used instead of basic authentication
+	oauthConfig := config.OAuth
+	if oauthConfig == nil {
+		oauthConfig = &pingaccessProviderOAuthModel{
+			TokenUrl:     types.StringNull(),
+			ClientId:     types.StringNull(),
+			ClientSecret: types.StringNull(),
+			Scopes:       types.ListNull(types.StringType),
+			AccessToken:  types.StringNull(),
+		}
+	}
+	oauthTokenUrl := getOptionalString(oauthConfig.TokenUrl, "oauth.token_url", "PINGACCESS_PROVIDER_OAUTH_TOKEN_URL", &resp.Diagnostics)
+	oauthClientId := getOptionalString(oauthConfig.ClientId, "oauth.client_id", "PINGACCESS_PROVIDER_OAUTH_CLIENT_ID", &resp.Diagnostics)
+	oauthClientSecret := getOptionalString(oauthConfig.ClientSecret, "oauth.client_secret", "PINGACCESS_PROVIDER_OAUTH_CLIENT_SECRET", &resp.Diagnostics)
+	oauthAccessToken := getOptionalString(oauthConfig.AccessToken, "oauth.access_token", "PINGACCESS_PROVIDER_OAUTH_ACCESS_TOKEN", &resp.Diagnostics)
+	var oauthScopes []string
+	if oauthConfig.Scopes.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unable to connect to the PingAccess Server",
+			"Cannot use unknown value as oauth.scopes",
+		)
+	} else if oauthConfig.Scopes.IsNull() {
+		if envScopes := os.Getenv("PINGACCESS_PROVIDER_OAUTH_SCOPES"); envScopes != "" {
+			oauthScopes = strings.Split(envScopes, ",")
+		}
+	} else {
+		resp.Diagnostics.Append(oauthConfig.Scopes.ElementsAs(ctx, &oauthScopes, false)...)
+	}
+
+	// Basic authentication is optional when a client certificate or OAuth is used to authenticate
+	basicAuthRequired := clientCertificatePem == "" && clientPkcs12 == "" && oauthTokenUrl == "" && oauthClientId == "" && oauthAccessToken == ""
+
 	// User must provide a username to the provider
 	var username string
 	if config.Username.IsUnknown() {
@@ -119,10 +426,10 @@ func (p *pingaccessProvider) Configure(ctx context.Context, req provider.Configu
 		} else {
 			username = config.Username.ValueString()
 		}
-		if username == "" {
+		if username == "" && basicAuthRequired {
 			resp.Diagnostics.AddError(
 				"Unable to find username",
-				"username cannot be an empty string. Either set it in the configuration or use the PINGACCESS_PROVIDER_USERNAME environment variable.",
+				"username cannot be an empty string unless a client certificate or OAuth is configured. Either set it in the configuration or use the PINGACCESS_PROVIDER_USERNAME environment variable.",
 			)
 		}
 	}
@@ -141,10 +448,10 @@ func (p *pingaccessProvider) Configure(ctx context.Context, req provider.Configu
 		} else {
 			password = config.Password.ValueString()
 		}
-		if password == "" {
+		if password == "" && basicAuthRequired {
 			resp.Diagnostics.AddError(
 				"Unable to find password",
-				"password cannot be an empty string. Either set it in the configuration or use the PINGACCESS_PROVIDER_PASSWORD environment variable.",
+				"password cannot be an empty string unless a client certificate or OAuth is configured. Either set it in the configuration or use the PINGACCESS_PROVIDER_PASSWORD environment variable.",
 			)
 		}
 	}
@@ -157,51 +464,258 @@ func (p *pingaccessProvider) Configure(ctx context.Context, req provider.Configu
 	// type Configure methods.
 	var resourceConfig internaltypes.ResourceConfiguration
 	providerConfig := internaltypes.ProviderConfiguration{
-		HttpsHost: httpsHost,
-		Username:  username,
-		Password:  password,
+		HttpsHost:                    httpsHost,
+		Username:                     username,
+		Password:                     password,
+		CaCertificatePem:             caCertificatePem,
+		ServerCertificateFingerprint: serverCertificateFingerprint,
+		TlsServerName:                tlsServerName,
+		InsecureTrustAll:             insecureTrustAll,
+		ClientCertificatePem:         clientCertificatePem,
+		ClientPrivateKeyPem:          clientPrivateKeyPem,
+		ClientPkcs12:                 clientPkcs12,
+		ClientPkcs12Password:         clientPkcs12Password,
+		OAuthTokenUrl:                oauthTokenUrl,
+		OAuthClientId:                oauthClientId,
+		OAuthClientSecret:            oauthClientSecret,
+		OAuthScopes:                  oauthScopes,
+		OAuthAccessToken:             oauthAccessToken,
+		RequestTimeout:               requestTimeout,
+		MaxRetries:                   maxRetries,
+		RetryMinBackoff:              retryMinBackoff,
+		RetryMaxBackoff:              retryMaxBackoff,
+		RetryableStatusCodes:         retryableStatusCodes,
+		RetryNonIdempotentRequests:   retryNonIdempotentRequests,
+		MaxConcurrentWrites:          maxConcurrentWrites,
+		MaxRequestsPerSecond:         maxRequestsPerSecond,
+		WaitForReplication:           waitForReplication,
+		ReplicationTimeout:           replicationTimeout,
+		HttpProxy:                    httpProxy,
+		NoProxy:                      noProxy,
+		ExtraHeaders:                 extraHeaders,
+		AdminApiPath:                 adminApiPath,
 	}
 	resourceConfig.ProviderConfig = providerConfig
 	clientConfig := client.NewConfiguration()
 	clientConfig.DefaultHeader["X-Xsrf-Header"] = "PingAccess"
 	clientConfig.Servers = client.ServerConfigurations{
 		{
-			URL: httpsHost + "/pa-admin-api/v3",
+			URL: strings.TrimSuffix(httpsHost, "/") + adminApiPath,
 		},
 	}
-	// TODO THIS IS NOT SAFE!! Eventually need to add way to trust a specific cert/signer here rather than just trusting everything
-	// https://stackoverflow.com/questions/12122159/how-to-do-a-https-request-with-bad-certificate
-	// #nosec G402
+	tlsConfig, err := buildTlsConfig(providerConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure TLS for the PingAccess admin API", err.Error())
+		return
+	}
+	if insecureTrustAll {
+		tflog.Warn(ctx, "insecure_trust_all is enabled, the PingAccess server certificate will not be verified")
+	}
+	proxyFunc, err := buildProxyFunc(providerConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure the proxy for the PingAccess admin API", err.Error())
+		return
+	}
 	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		Proxy:           proxyFunc,
+		TLSClientConfig: tlsConfig,
+	}
+	// Extra headers are added to every attempt of both admin API and token requests
+	headerTr := newHeaderTransport(tr, extraHeaders)
+	// Retry transient failures before they reach the generated client
+	tokenSource, err := buildOAuthTokenSource(providerConfig, &http.Client{Transport: newRetryTransport(headerTr, providerConfig)})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to configure OAuth for the PingAccess admin API", err.Error())
+		return
+	}
+	// Limit concurrent writes and request rate for every attempt of an admin API call, retries included.
+	// Token requests are not throttled.
+	apiTransport := newRetryTransport(newThrottleTransport(headerTr, providerConfig), providerConfig)
+	httpClient := &http.Client{Transport: apiTransport}
+	if tokenSource != nil {
+		// Bearer tokens are added to every admin API request by the transport
+		httpClient = &http.Client{
+			Transport: &oauth2.Transport{
+				Source: tokenSource,
+				Base:   apiTransport,
+			},
+		}
 	}
-	httpClient := &http.Client{Transport: tr}
 	clientConfig.HTTPClient = httpClient
 	resourceConfig.ApiClient = client.NewAPIClient(clientConfig)
+
+	// Record the server version so resources can report attributes the connected release doesn't support
+	serverVersion, err := getServerVersion(ctx, resourceConfig.ApiClient, providerConfig)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to get the PingAccess server version", "Version checks will be skipped. "+err.Error())
+	} else {
+		resourceConfig.ServerVersion = serverVersion
+		tflog.Info(ctx, "Connected to PingAccess "+serverVersion)
+	}
 	resp.ResourceData = resourceConfig
+	resp.DataSourceData = resourceConfig
 
 	tflog.Info(ctx, "Configured PingAccess client", map[string]interface{}{"success": true})
 }
 
+// Get an optional string from the configuration, falling back to the given environment variable
+func getOptionalString(value types.String, attributeName, envVar string, diagnostics *diag.Diagnostics) string {
+	if value.IsUnknown() {
+		diagnostics.AddError(
+			"Unable to connect to the PingAccess Server",
+			"Cannot use unknown value as "+attributeName,
+		)
+		return ""
+	}
+	if value.IsNull() {
+		return os.Getenv(envVar)
+	}
+	return value.ValueString()
+}
+
+// Get an optional bool from the configuration, falling back to the given environment variable
+func getOptionalBool(value types.Bool, attributeName, envVar string, diagnostics *diag.Diagnostics) bool {
+	if value.IsUnknown() {
+		diagnostics.AddError(
+			"Unable to connect to the PingAccess Server",
+			"Cannot use unknown value as "+attributeName,
+		)
+		return false
+	}
+	if !value.IsNull() {
+		return value.ValueBool()
+	}
+	envValue := os.Getenv(envVar)
+	if envValue == "" {
+		return false
+	}
+	boolValue, err := strconv.ParseBool(envValue)
+	if err != nil {
+		diagnostics.AddError(
+			"Invalid value for "+attributeName,
+			"The "+envVar+" environment variable must be a boolean value: "+err.Error(),
+		)
+	}
+	return boolValue
+}
+
+// Get an optional int64 from the configuration, falling back to the given environment variable and then the default
+func getOptionalInt64(value types.Int64, attributeName, envVar string, defaultValue int64, diagnostics *diag.Diagnostics) int64 {
+	if value.IsUnknown() {
+		diagnostics.AddError(
+			"Unable to connect to the PingAccess Server",
+			"Cannot use unknown value as "+attributeName,
+		)
+		return defaultValue
+	}
+	if !value.IsNull() {
+		return value.ValueInt64()
+	}
+	envValue := os.Getenv(envVar)
+	if envValue == "" {
+		return defaultValue
+	}
+	intValue, err := strconv.ParseInt(envValue, 10, 64)
+	if err != nil {
+		diagnostics.AddError(
+			"Invalid value for "+attributeName,
+			"The "+envVar+" environment variable must be an integer: "+err.Error(),
+		)
+		return defaultValue
+	}
+	return intValue
+}
+
+// Get an optional float64 from the configuration, falling back to the given environment variable and then the default
+func getOptionalFloat64(value types.Float64, attributeName, envVar string, defaultValue float64, diagnostics *diag.Diagnostics) float64 {
+	if value.IsUnknown() {
+		diagnostics.AddError(
+			"Unable to connect to the PingAccess Server",
+			"Cannot use unknown value as "+attributeName,
+		)
+		return defaultValue
+	}
+	if !value.IsNull() {
+		return value.ValueFloat64()
+	}
+	envValue := os.Getenv(envVar)
+	if envValue == "" {
+		return defaultValue
+	}
+	floatValue, err := strconv.ParseFloat(envValue, 64)
+	if err != nil {
+		diagnostics.AddError(
+			"Invalid value for "+attributeName,
+			"The "+envVar+" environment variable must be a number: "+err.Error(),
+		)
+		return defaultValue
+	}
+	return floatValue
+}
+
+// Get an optional duration from the configuration, falling back to the given environment variable and then the default
+func getOptionalDuration(value types.String, attributeName, envVar string, defaultValue time.Duration, diagnostics *diag.Diagnostics) time.Duration {
+	stringValue := getOptionalString(value, attributeName, envVar, diagnostics)
+	if stringValue == "" {
+		return defaultValue
+	}
+	duration, err := time.ParseDuration(stringValue)
+	if err != nil {
+		diagnostics.AddError(
+			"Invalid value for "+attributeName,
+			attributeName+" must be a duration such as \"30s\": "+err.Error(),
+		)
+		return defaultValue
+	}
+	return duration
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *pingaccessProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		accessTokenValidator.AccessTokenValidatorDataSource,
+		acmeServers.AcmeServerDataSource,
+		authnReqList.AuthnReqListDataSource,
+		certificates.CertificateDataSource,
+		certificates.CertificatesDataSource,
+		engineListener.EngineListenerDataSource,
+		highAvailabilityProfiles.AvailabilityProfileDataSource,
+		hsmProvider.HsmProviderDataSource,
+		keyPairs.KeyPairCsrDataSource,
+		proxies.HttpClientProxyDataSource,
+		sites.SiteDataSource,
+		sites.SitesDataSource,
+		thirdPartyService.ThirdPartyServiceDataSource,
+		trustedCertificateGroup.TrustedCertificateGroupDataSource,
+		virtualHost.VirtualHostDataSource,
+		virtualHost.VirtualHostsDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *pingaccessProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		accessTokenValidator.AccessTokenValidatorResource,
+		acmeAccounts.AcmeAccountResource,
+		acmeCertificateRequests.AcmeCertificateRequestResource,
 		acmeServers.AcmeServerResource,
+		applicationResources.ApplicationResourceResource,
+		applications.ApplicationResource,
 		authnReqList.AuthnReqListResource,
 		certificates.CertificateResource,
 		engineListener.EngineListenerResource,
 		highAvailabilityProfiles.AvailabilityProfileResource,
 		hsmProvider.HsmProviderResource,
+		keyPairs.KeyPairResource,
+		keyPairs.KeyPairCsrResponseResource,
 		proxies.HttpClientProxyResource,
+		rules.RuleResource,
+		rulesets.RuleSetResource,
+		rulesets.RuleSetGroupResource,
 		sites.SiteResource,
 		thirdPartyService.ThirdPartyServiceResource,
 		trustedCertificateGroup.TrustedCertificateGroupResource,
 		virtualHost.VirtualHostResource,
+		webSessions.WebSessionResource,
 	}
 }
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Build a provider configuration with the given attributes set and everything else null
+func testProviderConfig(t *testing.T, p provider.Provider, attributes map[string]string) tfsdk.Config {
+	ctx := context.Background()
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("expected the provider schema to be an object")
+	}
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range attributes {
+		values[name] = tftypes.NewValue(tftypes.String, value)
+	}
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, values),
+	}
+}
+
+// Resources and data sources must both receive the configured API client
+func TestConfigureSetsResourceAndDataSourceData(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version":"7.2.0"}`))
+	}))
+	defer server.Close()
+
+	p := New()
+	config := testProviderConfig(t, p, map[string]string{
+		"https_host": server.URL,
+		"username":   "administrator",
+		"password":   "2Access",
+	})
+	var resp provider.ConfigureResponse
+	p.Configure(context.Background(), provider.ConfigureRequest{Config: config}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error configuring the provider: %v", resp.Diagnostics)
+	}
+
+	resourceConfig, ok := resp.ResourceData.(internaltypes.ResourceConfiguration)
+	if !ok || resourceConfig.ApiClient == nil {
+		t.Fatalf("expected resources to receive the API client, got %v", resp.ResourceData)
+	}
+	dataSourceConfig, ok := resp.DataSourceData.(internaltypes.ResourceConfiguration)
+	if !ok || dataSourceConfig.ApiClient == nil {
+		t.Fatalf("expected data sources to receive the API client, got %v", resp.DataSourceData)
+	}
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Transport that limits how many add, update and delete requests run against the admin API at once,
+// and optionally how many requests of any kind are sent per second. Parallel changes can otherwise
+// hit optimistic-locking conflicts on the PingAccess server. The retry transport wraps this one, so each
+// attempt counts against the limits, and a write slot is released while waiting before a retry.
+type throttleTransport struct {
+	base        http.RoundTripper
+	writeSlots  chan struct{}
+	minInterval time.Duration
+	mutex       sync.Mutex
+	nextRequest time.Time
+}
+
+func newThrottleTransport(base http.RoundTripper, providerConfig internaltypes.ProviderConfiguration) *throttleTransport {
+	t := &throttleTransport{
+		base: base,
+	}
+	if providerConfig.MaxConcurrentWrites > 0 {
+		t.writeSlots = make(chan struct{}, providerConfig.MaxConcurrentWrites)
+	}
+	if providerConfig.MaxRequestsPerSecond > 0 {
+		t.minInterval = time.Duration(float64(time.Second) / providerConfig.MaxRequestsPerSecond)
+	}
+	return t
+}
+
+func (t *throttleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if t.writeSlots != nil && isWrite(req.Method) {
+		select {
+		case t.writeSlots <- struct{}{}:
+			defer func() { <-t.writeSlots }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if delay := t.reserve(); delay > 0 {
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+// Reserve the next request slot allowed by the rate limit, returning how long to wait for it
+func (t *throttleTransport) reserve() time.Duration {
+	if t.minInterval <= 0 {
+		return 0
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	now := time.Now()
+	if t.nextRequest.Before(now) {
+		t.nextRequest = now
+	}
+	delay := t.nextRequest.Sub(now)
+	t.nextRequest = t.nextRequest.Add(t.minInterval)
+	return delay
+}
+
+func isWrite(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Retries must go through the rate limit, and must not hold a write slot while waiting
+func TestThrottleAppliesToEachAttempt(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	providerConfig := internaltypes.ProviderConfiguration{
+		MaxRetries:                 4,
+		RetryMinBackoff:            time.Millisecond,
+		RetryMaxBackoff:            time.Millisecond,
+		RetryableStatusCodes:       defaultRetryableStatusCodes,
+		RetryNonIdempotentRequests: true,
+		MaxConcurrentWrites:        1,
+		MaxRequestsPerSecond:       10,
+	}
+	throttle := newThrottleTransport(http.DefaultTransport, providerConfig)
+	transport := newRetryTransport(throttle, providerConfig)
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+	// Three attempts at 10 requests per second take at least 200ms
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("expected retries to be rate limited, but all attempts took %s", elapsed)
+	}
+	if len(throttle.writeSlots) != 0 {
+		t.Errorf("expected the write slot to be released, %d still held", len(throttle.writeSlots))
+	}
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+	"golang.org/x/crypto/pkcs12"
+)
+
+// Build the TLS configuration used to connect to the PingAccess admin API
+func buildTlsConfig(providerConfig internaltypes.ProviderConfiguration) (*tls.Config, error) {
+	clientCertificates, err := loadClientCertificates(providerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if providerConfig.InsecureTrustAll {
+		if providerConfig.CaCertificatePem != "" || providerConfig.ServerCertificateFingerprint != "" {
+			return nil, errors.New("insecure_trust_all cannot be combined with ca_certificate_pem or server_certificate_fingerprint")
+		}
+		// The user has explicitly opted in to trusting any server certificate
+		// #nosec G402
+		return &tls.Config{
+			InsecureSkipVerify: true,
+			ServerName:         providerConfig.TlsServerName,
+			Certificates:       clientCertificates,
+		}, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		ServerName:   providerConfig.TlsServerName,
+		Certificates: clientCertificates,
+	}
+
+	if providerConfig.CaCertificatePem != "" {
+		pemBytes, err := readPemValue(providerConfig.CaCertificatePem)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read ca_certificate_pem: %w", err)
+		}
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(pemBytes) {
+			return nil, errors.New("ca_certificate_pem does not contain any valid PEM-encoded certificates")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if providerConfig.ServerCertificateFingerprint != "" {
+		fingerprint, err := parseFingerprint(providerConfig.ServerCertificateFingerprint)
+		if err != nil {
+			return nil, err
+		}
+		if tlsConfig.RootCAs == nil {
+			// With no CA bundle the pinned fingerprint is the only trust anchor, so the chain
+			// is not verified and the leaf certificate must match the pin instead.
+			// #nosec G402
+			tlsConfig.InsecureSkipVerify = true
+		}
+		tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+			if len(state.PeerCertificates) == 0 {
+				return errors.New("the PingAccess server did not present a certificate")
+			}
+			found := sha256.Sum256(state.PeerCertificates[0].Raw)
+			if !bytes.Equal(found[:], fingerprint) {
+				return fmt.Errorf("the PingAccess server certificate fingerprint %s does not match server_certificate_fingerprint", hex.EncodeToString(found[:]))
+			}
+			return nil
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// Load the client certificate presented for mutual TLS, if one is configured
+func loadClientCertificates(providerConfig internaltypes.ProviderConfiguration) ([]tls.Certificate, error) {
+	usePem := providerConfig.ClientCertificatePem != "" || providerConfig.ClientPrivateKeyPem != ""
+	usePkcs12 := providerConfig.ClientPkcs12 != ""
+	if usePem && usePkcs12 {
+		return nil, errors.New("client_pkcs12 cannot be combined with client_certificate_pem or client_private_key_pem")
+	}
+
+	if usePem {
+		if providerConfig.ClientCertificatePem == "" || providerConfig.ClientPrivateKeyPem == "" {
+			return nil, errors.New("client_certificate_pem and client_private_key_pem must be set together")
+		}
+		certPem, err := readPemValue(providerConfig.ClientCertificatePem)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client_certificate_pem: %w", err)
+		}
+		keyPem, err := readPemValue(providerConfig.ClientPrivateKeyPem)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client_private_key_pem: %w", err)
+		}
+		certificate, err := tls.X509KeyPair(certPem, keyPem)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		return []tls.Certificate{certificate}, nil
+	}
+
+	if usePkcs12 {
+		pfxData, err := readPkcs12Value(providerConfig.ClientPkcs12)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client_pkcs12: %w", err)
+		}
+		blocks, err := pkcs12.ToPEM(pfxData, providerConfig.ClientPkcs12Password)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode client_pkcs12: %w", err)
+		}
+		certificate, err := pkcs12KeyPair(blocks)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate from client_pkcs12: %w", err)
+		}
+		return []tls.Certificate{certificate}, nil
+	}
+
+	return nil, nil
+}
+
+// Build a key pair from the decoded contents of a PKCS#12 file. The bags can be in any order, so the
+// leaf is the certificate whose public key matches the private key, and the others form its chain.
+func pkcs12KeyPair(blocks []*pem.Block) (tls.Certificate, error) {
+	var keyPem []byte
+	var certBlocks []*pem.Block
+	for _, block := range blocks {
+		if block.Type == "CERTIFICATE" {
+			certBlocks = append(certBlocks, block)
+		} else if keyPem == nil {
+			keyPem = pem.EncodeToMemory(block)
+		}
+	}
+	if keyPem == nil {
+		return tls.Certificate{}, errors.New("no private key found")
+	}
+	if len(certBlocks) == 0 {
+		return tls.Certificate{}, errors.New("no certificate found")
+	}
+	var lastErr error
+	for i, leaf := range certBlocks {
+		// X509KeyPair checks the private key against the first certificate
+		certPem := pem.EncodeToMemory(leaf)
+		for j, block := range certBlocks {
+			if j != i {
+				certPem = append(certPem, pem.EncodeToMemory(block)...)
+			}
+		}
+		certificate, err := tls.X509KeyPair(certPem, keyPem)
+		if err == nil {
+			return certificate, nil
+		}
+		lastErr = err
+	}
+	return tls.Certificate{}, fmt.Errorf("no certificate matches the private key: %w", lastErr)
+}
+
+// Read a value that is either a path to a PKCS#12 file or base64-encoded PKCS#12 content
+func readPkcs12Value(value string) ([]byte, error) {
+	if _, err := os.Stat(value); err == nil {
+		// #nosec G304
+		return os.ReadFile(value)
+	}
+	return base64.StdEncoding.DecodeString(value)
+}
+
+// Read a value that is either PEM content or a path to a file containing PEM content
+func readPemValue(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	// #nosec G304
+	return os.ReadFile(value)
+}
+
+// Parse a hex-encoded SHA-256 fingerprint, allowing colon or space separators
+func parseFingerprint(value string) ([]byte, error) {
+	normalized := strings.NewReplacer(":", "", " ", "").Replace(strings.ToLower(value))
+	fingerprint, err := hex.DecodeString(normalized)
+	if err != nil || len(fingerprint) != sha256.Size {
+		return nil, errors.New("server_certificate_fingerprint must be a hex-encoded SHA-256 fingerprint")
+	}
+	return fingerprint, nil
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func testCertificate(t *testing.T, serial int64, commonName string) (*pem.Block, *pem.Block) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &pem.Block{Type: "CERTIFICATE", Bytes: der}, &pem.Block{Type: "PRIVATE KEY", Bytes: keyDer}
+}
+
+// The leaf must be found by its private key wherever it appears in the PKCS#12 bags
+func TestPkcs12KeyPairSelectsLeaf(t *testing.T) {
+	caCert, _ := testCertificate(t, 1, "ca")
+	leafCert, leafKey := testCertificate(t, 2, "leaf")
+	tests := []struct {
+		name   string
+		blocks []*pem.Block
+		valid  bool
+	}{
+		{"leaf first", []*pem.Block{leafKey, leafCert, caCert}, true},
+		{"leaf last", []*pem.Block{caCert, leafKey, leafCert}, true},
+		{"no matching certificate", []*pem.Block{leafKey, caCert}, false},
+		{"no private key", []*pem.Block{leafCert, caCert}, false},
+		{"no certificate", []*pem.Block{leafKey}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			certificate, err := pkcs12KeyPair(test.blocks)
+			if !test.valid {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(certificate.Certificate) != 2 {
+				t.Fatalf("expected the leaf and its chain, got %d certificates", len(certificate.Certificate))
+			}
+			if !bytes.Equal(certificate.Certificate[0], leafCert.Bytes) {
+				t.Error("expected the leaf certificate to come first")
+			}
+		})
+	}
+}
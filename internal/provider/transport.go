@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// Default retry settings, used when not set in the provider configuration
+const (
+	defaultMaxRetries      = 4
+	defaultRetryMinBackoff = time.Second
+	defaultRetryMaxBackoff = 30 * time.Second
+	defaultRequestTimeout  = 60 * time.Second
+)
+
+var defaultRetryableStatusCodes = []int64{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// Get the proxy function for admin API requests. Without http_proxy or no_proxy set, the standard
+// HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables are used.
+func buildProxyFunc(providerConfig internaltypes.ProviderConfiguration) (func(*http.Request) (*url.URL, error), error) {
+	if providerConfig.HttpProxy == "" && providerConfig.NoProxy == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+	proxyConfig := httpproxy.FromEnvironment()
+	if providerConfig.HttpProxy != "" {
+		proxyUrl, err := url.Parse(providerConfig.HttpProxy)
+		if err != nil || proxyUrl.Host == "" {
+			return nil, fmt.Errorf("http_proxy must be a URL such as http://proxy.example.com:8080")
+		}
+		proxyConfig.HTTPProxy = providerConfig.HttpProxy
+		proxyConfig.HTTPSProxy = providerConfig.HttpProxy
+	}
+	if providerConfig.NoProxy != "" {
+		proxyConfig.NoProxy = providerConfig.NoProxy
+	}
+	proxyFunc := proxyConfig.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}, nil
+}
+
+// Transport that retries transient admin API failures with exponential backoff and jitter.
+// Each attempt is bounded by the request timeout, in addition to any deadline on the request context.
+type retryTransport struct {
+	base                       http.RoundTripper
+	requestTimeout             time.Duration
+	maxRetries                 int64
+	minBackoff                 time.Duration
+	maxBackoff                 time.Duration
+	retryableStatusCodes       []int64
+	retryNonIdempotentRequests bool
+}
+
+func newRetryTransport(base http.RoundTripper, providerConfig internaltypes.ProviderConfiguration) *retryTransport {
+	return &retryTransport{
+		base:                       base,
+		requestTimeout:             providerConfig.RequestTimeout,
+		maxRetries:                 providerConfig.MaxRetries,
+		minBackoff:                 providerConfig.RetryMinBackoff,
+		maxBackoff:                 providerConfig.RetryMaxBackoff,
+		retryableStatusCodes:       providerConfig.RetryableStatusCodes,
+		retryNonIdempotentRequests: providerConfig.RetryNonIdempotentRequests,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := int64(0); ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.Body != nil {
+			// The previous attempt consumed the body, so get a fresh copy to send again
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		cancel := func() {}
+		if t.requestTimeout > 0 {
+			var attemptCtx context.Context
+			attemptCtx, cancel = context.WithTimeout(ctx, t.requestTimeout)
+			attemptReq = attemptReq.WithContext(attemptCtx)
+		}
+
+		resp, err := t.base.RoundTrip(attemptReq)
+		if attempt >= t.maxRetries || !t.shouldRetry(req, resp, err) {
+			if err != nil {
+				cancel()
+				return resp, err
+			}
+			// The timeout also covers reading the response body, so only release it once the body is closed
+			resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+			return resp, nil
+		}
+
+		delay := t.backoff(attempt, resp)
+		logFields := map[string]interface{}{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"attempt": attempt + 1,
+			"delay":   delay.String(),
+		}
+		if err != nil {
+			logFields["error"] = err.Error()
+		} else {
+			logFields["status"] = resp.StatusCode
+			// Drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		cancel()
+		tflog.Warn(ctx, "Retrying PingAccess admin API request after a transient failure", logFields)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Determine whether a request should be retried based on its method and outcome
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if !t.retryNonIdempotentRequests && !isIdempotent(req.Method) {
+		return false
+	}
+	// A body that can't be replayed can't be sent again
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+	if err != nil {
+		// Don't retry if the operation itself was cancelled or ran out of time. An attempt
+		// that only exceeded the request timeout can be retried.
+		return req.Context().Err() == nil
+	}
+	for _, code := range t.retryableStatusCodes {
+		if int64(resp.StatusCode) == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Get the delay before the next attempt, honoring any Retry-After header from the server
+func (t *retryTransport) backoff(attempt int64, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			delay := time.Duration(seconds) * time.Second
+			if delay > t.maxBackoff {
+				return t.maxBackoff
+			}
+			return delay
+		}
+	}
+	delay := t.minBackoff << attempt
+	if delay <= 0 || delay > t.maxBackoff {
+		delay = t.maxBackoff
+	}
+	// Add jitter so that parallel operations don't retry in lockstep
+	// #nosec G404
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// Response body that releases the request timeout when closed
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
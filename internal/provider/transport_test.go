@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+func testRetryConfig() internaltypes.ProviderConfiguration {
+	return internaltypes.ProviderConfiguration{
+		MaxRetries:           2,
+		RetryMinBackoff:      time.Millisecond,
+		RetryMaxBackoff:      time.Millisecond,
+		RetryableStatusCodes: defaultRetryableStatusCodes,
+	}
+}
+
+func TestIsIdempotent(t *testing.T) {
+	tests := []struct {
+		method     string
+		idempotent bool
+	}{
+		{http.MethodGet, true},
+		{http.MethodHead, true},
+		{http.MethodOptions, true},
+		{http.MethodPut, true},
+		{http.MethodDelete, true},
+		{http.MethodPost, false},
+		{http.MethodPatch, false},
+	}
+	for _, test := range tests {
+		if isIdempotent(test.method) != test.idempotent {
+			t.Errorf("%s: expected idempotent to be %t", test.method, test.idempotent)
+		}
+	}
+}
+
+func TestRetryTransportRetries(t *testing.T) {
+	tests := []struct {
+		name                       string
+		method                     string
+		status                     int
+		retryNonIdempotentRequests bool
+		expectedAttempts           int32
+	}{
+		{"internal server error", http.MethodGet, http.StatusInternalServerError, false, 3},
+		{"service unavailable", http.MethodPut, http.StatusServiceUnavailable, false, 3},
+		{"not retryable status", http.MethodGet, http.StatusNotFound, false, 1},
+		{"non-idempotent request", http.MethodPost, http.StatusServiceUnavailable, false, 1},
+		{"non-idempotent request allowed", http.MethodPost, http.StatusServiceUnavailable, true, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var attempts int32
+			var lastBody string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&attempts, 1)
+				body, _ := io.ReadAll(r.Body)
+				lastBody = string(body)
+				w.WriteHeader(test.status)
+			}))
+			defer server.Close()
+
+			providerConfig := testRetryConfig()
+			providerConfig.RetryNonIdempotentRequests = test.retryNonIdempotentRequests
+			req, err := http.NewRequest(test.method, server.URL, strings.NewReader("{}"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := newRetryTransport(http.DefaultTransport, providerConfig).RoundTrip(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if attempts != test.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", test.expectedAttempts, attempts)
+			}
+			if resp.StatusCode != test.status {
+				t.Errorf("expected status %d, got %d", test.status, resp.StatusCode)
+			}
+			// Every attempt must send the full request body
+			if lastBody != "{}" {
+				t.Errorf("expected the request body to be sent again, got %q", lastBody)
+			}
+		})
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	transport := &retryTransport{
+		minBackoff: 100 * time.Millisecond,
+		maxBackoff: 2 * time.Second,
+	}
+	tests := []struct {
+		name       string
+		attempt    int64
+		retryAfter string
+		min        time.Duration
+		max        time.Duration
+	}{
+		{"first attempt", 0, "", 50 * time.Millisecond, 100 * time.Millisecond},
+		{"third attempt", 2, "", 200 * time.Millisecond, 400 * time.Millisecond},
+		{"capped at max backoff", 10, "", time.Second, 2 * time.Second},
+		{"overflow capped at max backoff", 70, "", time.Second, 2 * time.Second},
+		{"retry after", 0, "1", time.Second, time.Second},
+		{"retry after capped at max backoff", 0, "120", 2 * time.Second, 2 * time.Second},
+		{"retry after zero", 3, "0", 0, 0},
+		{"invalid retry after", 0, "soon", 50 * time.Millisecond, 100 * time.Millisecond},
+		{"negative retry after", 0, "-1", 50 * time.Millisecond, 100 * time.Millisecond},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if test.retryAfter != "" {
+				resp.Header.Set("Retry-After", test.retryAfter)
+			}
+			// Jitter is random, so check the bounds over several samples
+			for i := 0; i < 20; i++ {
+				delay := transport.backoff(test.attempt, resp)
+				if delay < test.min || delay > test.max {
+					t.Fatalf("expected a delay between %s and %s, got %s", test.min, test.max, delay)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryTransportRequestTimeoutReleasedOnClose(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	providerConfig := testRetryConfig()
+	providerConfig.RequestTimeout = time.Minute
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := newRetryTransport(http.DefaultTransport, providerConfig).RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, ok := resp.Body.(*cancelOnCloseBody)
+	if !ok {
+		t.Fatalf("expected the response body to release the request timeout, got %T", resp.Body)
+	}
+	// The body must stay readable until it's closed
+	data, err := io.ReadAll(body)
+	if err != nil || string(data) != "ok" {
+		t.Fatalf("expected to read the response body, got %q, %v", data, err)
+	}
+	attemptCtx := resp.Request.Context()
+	if attemptCtx.Err() != nil {
+		t.Fatal("expected the request timeout to be active before the body is closed")
+	}
+	resp.Body.Close()
+	if attemptCtx.Err() != context.Canceled {
+		t.Errorf("expected closing the body to release the request timeout, got %v", attemptCtx.Err())
+	}
+}
+
+func TestCancelOnCloseBody(t *testing.T) {
+	cancelled := false
+	body := &cancelOnCloseBody{
+		ReadCloser: io.NopCloser(strings.NewReader("data")),
+		cancel:     func() { cancelled = true },
+	}
+	if _, err := io.ReadAll(body); err != nil {
+		t.Fatal(err)
+	}
+	if cancelled {
+		t.Fatal("expected cancel to wait until the body is closed")
+	}
+	if err := body.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !cancelled {
+		t.Error("expected closing the body to call cancel")
+	}
+}
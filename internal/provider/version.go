@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Get the version of the connected PingAccess server from the version endpoint
+func getServerVersion(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) (string, error) {
+	version, httpResp, err := apiClient.VersionApi.GetVersion(config.AuthContext(ctx, providerConfig)).Execute()
+	if err != nil {
+		if httpResp != nil {
+			return "", fmt.Errorf("an error occurred while calling the version endpoint (%s): %w", httpResp.Status, err)
+		}
+		return "", fmt.Errorf("an error occurred while calling the version endpoint: %w", err)
+	}
+	if version.GetVersion() == "" {
+		return "", errors.New("the version endpoint did not return a version")
+	}
+	return version.GetVersion(), nil
+}
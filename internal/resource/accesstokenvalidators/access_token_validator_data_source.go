@@ -0,0 +1,160 @@
+package accessTokenValidators
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &accessTokenValidatorDataSource{}
+	_ datasource.DataSourceWithConfigure = &accessTokenValidatorDataSource{}
+)
+
+// AccessTokenValidatorDataSource is a helper function to simplify the provider implementation.
+func AccessTokenValidatorDataSource() datasource.DataSource {
+	return &accessTokenValidatorDataSource{}
+}
+
+// accessTokenValidatorDataSource is the data source implementation.
+type accessTokenValidatorDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type accessTokenValidatorDataSourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	ClassName     types.String `tfsdk:"classname"`
+	Configuration types.Object `tfsdk:"configuration"`
+}
+
+// Schema defines the schema for the data source.
+func (d *accessTokenValidatorDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an Access Token Validator by id or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the Access Token Validator. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Exact name of the Access Token Validator. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"classname": schema.StringAttribute{
+				Computed: true,
+			},
+			"configuration": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"audience": schema.StringAttribute{
+						Computed: true,
+					},
+					"description": schema.StringAttribute{
+						Computed: true,
+					},
+					"issuer": schema.StringAttribute{
+						Computed: true,
+					},
+					"path": schema.StringAttribute{
+						Computed: true,
+					},
+					"subject_attribute_name": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *accessTokenValidatorDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token_validator"
+}
+
+func (d *accessTokenValidatorDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	d.providerConfig = providerCfg.ProviderConfig
+	d.apiClient = providerCfg.ApiClient
+}
+
+func (d *accessTokenValidatorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state accessTokenValidatorDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.CheckDataSourceLookup(&resp.Diagnostics, state.Id, state.Name, "name") {
+		return
+	}
+
+	var accessTokenValidator *client.AccessTokenValidator
+	if internaltypes.IsDefined(state.Id) {
+		apiReadAccessTokenValidator, httpResp, err := d.apiClient.AccessTokenValidatorsApi.GetAccessTokenValidator(config.AuthContext(ctx, d.providerConfig), state.Id.ValueString()).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an Access Token Validator", err, httpResp)
+			return
+		}
+		accessTokenValidator = apiReadAccessTokenValidator
+	} else {
+		name := state.Name.ValueString()
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.AccessTokenValidator, *http.Response, error) {
+			apiReadAccessTokenValidators, httpResp, err := d.apiClient.AccessTokenValidatorsApi.GetAccessTokenValidators(config.AuthContext(ctx, d.providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadAccessTokenValidators.GetItems(), httpResp, nil
+		})
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an Access Token Validator", err, httpResp)
+			return
+		}
+		// Only exact matches count
+		var matches []client.AccessTokenValidator
+		for _, item := range items {
+			if item.Name == name {
+				matches = append(matches, item)
+			}
+		}
+		if !config.CheckLookupMatches(&resp.Diagnostics, "Access Token Validator", "name \""+name+"\"", len(matches)) {
+			return
+		}
+		accessTokenValidator = &matches[0]
+	}
+	// Log response JSON
+	responseJson, err := accessTokenValidator.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response using the resource mapper
+	var model accessTokenValidatorResourceModel
+	readAccessTokenValidatorResponse(ctx, accessTokenValidator, &model, &model, &resp.Diagnostics)
+	state = accessTokenValidatorDataSourceModel{
+		Id:            model.Id,
+		Name:          model.Name,
+		ClassName:     model.ClassName,
+		Configuration: model.Configuration,
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
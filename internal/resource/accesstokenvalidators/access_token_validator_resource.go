@@ -2,10 +2,11 @@ package accessTokenValidators
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -38,10 +39,11 @@ type accessTokenValidatorResource struct {
 }
 
 type accessTokenValidatorResourceModel struct {
-	ClassName     types.String `tfsdk:"classname"`
-	Id            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	Configuration types.Object `tfsdk:"configuration"`
+	ClassName     types.String   `tfsdk:"classname"`
+	Id            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	Configuration types.Object   `tfsdk:"configuration"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -115,6 +117,12 @@ func accessTokenValidatorResourceSchema(ctx context.Context, req resource.Schema
 	if setOptionalToComputed {
 		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"classname", "name", "configuration"})
 	}
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
 	resp.Schema = schema
 }
 
@@ -175,6 +183,13 @@ func (r *accessTokenValidatorResource) Create(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 	createAccessTokenValidator := client.NewAccessTokenValidator(plan.ClassName.ValueString(), plan.Name.ValueString())
 	err := addOptionalAccessTokenValidatorFields(ctx, createAccessTokenValidator, plan)
 	if err != nil {
@@ -185,13 +200,14 @@ func (r *accessTokenValidatorResource) Create(ctx context.Context, req resource.
 	if err == nil {
 		tflog.Debug(ctx, "Add request: "+string(requestJson))
 	}
-	apiCreateAccessTokenValidator := r.apiClient.AccessTokenValidatorsApi.AddAccessTokenValidator(config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiCreateAccessTokenValidator := r.apiClient.AccessTokenValidatorsApi.AddAccessTokenValidator(config.AuthContext(ctx, r.providerConfig))
 	apiCreateAccessTokenValidator = apiCreateAccessTokenValidator.AccessTokenValidator(*createAccessTokenValidator)
 	accessTokenValidatorResponse, httpResp, err := r.apiClient.AccessTokenValidatorsApi.AddAccessTokenValidatorExecute(apiCreateAccessTokenValidator)
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating Access Token Validator", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
 	responseJson, err := accessTokenValidatorResponse.MarshalJSON()
 	if err == nil {
 		tflog.Debug(ctx, "Add response: "+string(responseJson))
@@ -201,6 +217,7 @@ func (r *accessTokenValidatorResource) Create(ctx context.Context, req resource.
 	var state accessTokenValidatorResourceModel
 
 	readAccessTokenValidatorResponse(ctx, accessTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -220,8 +237,18 @@ func readAccessTokenValidator(ctx context.Context, req resource.ReadRequest, res
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
-	apiReadAccessTokenValidator, httpResp, err := apiClient.AccessTokenValidatorsApi.GetAccessTokenValidator(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	apiReadAccessTokenValidator, httpResp, err := apiClient.AccessTokenValidatorsApi.GetAccessTokenValidator(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "Access Token Validator", httpResp) {
+		return
+	}
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an Access Token Validator", err, httpResp)
 		return
@@ -257,11 +284,18 @@ func updateAccessTokenValidator(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Get the current state to see how any attributes are changing
 	var state accessTokenValidatorResourceModel
 	req.State.Get(ctx, &state)
-	UpdateAccessTokenValidator := apiClient.AccessTokenValidatorsApi.UpdateAccessTokenValidator(config.ProviderBasicAuthContext(ctx, providerConfig), plan.Id.ValueString())
+	UpdateAccessTokenValidator := apiClient.AccessTokenValidatorsApi.UpdateAccessTokenValidator(config.AuthContext(ctx, providerConfig), plan.Id.ValueString())
 	CreateUpdateRequest := client.NewAccessTokenValidator(plan.ClassName.ValueString(), plan.Name.ValueString())
 	err := addOptionalAccessTokenValidatorFields(ctx, CreateUpdateRequest, plan)
 	if err != nil {
@@ -278,6 +312,7 @@ func updateAccessTokenValidator(ctx context.Context, req resource.UpdateRequest,
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating Access Token Validator", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 	// Log response JSON
 	responseJson, err := UpdateAccessTokenValidatorResponse.MarshalJSON()
 	if err == nil {
@@ -287,6 +322,7 @@ func updateAccessTokenValidator(ctx context.Context, req resource.UpdateRequest,
 	readAccessTokenValidatorResponse(ctx, UpdateAccessTokenValidatorResponse, &state, &plan, &resp.Diagnostics)
 
 	// Update computed values
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -307,16 +343,40 @@ func deleteAccessTokenValidator(ctx context.Context, req resource.DeleteRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	httpResp, err := apiClient.AccessTokenValidatorsApi.DeleteAccessTokenValidator(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	httpResp, err := apiClient.AccessTokenValidatorsApi.DeleteAccessTokenValidator(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting Access Token Validator", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 }
 
 func (r *accessTokenValidatorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	importLocation(ctx, req, resp)
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
 }
-func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve import ID and save to id attribute, looking the ID up first when importing by name
+	config.ImportByIdOrName(ctx, req, resp, "Access Token Validator", func(name string) ([]string, *http.Response, error) {
+		var ids []string
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.AccessTokenValidator, *http.Response, error) {
+			apiReadAccessTokenValidators, httpResp, err := apiClient.AccessTokenValidatorsApi.GetAccessTokenValidators(config.AuthContext(ctx, providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadAccessTokenValidators.GetItems(), httpResp, nil
+		})
+		for i := range items {
+			if items[i].Name == name {
+				ids = append(ids, internaltypes.Int64PointerToString(*items[i].Id))
+			}
+		}
+		return ids, httpResp, err
+	})
 }
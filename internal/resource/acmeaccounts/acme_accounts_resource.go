@@ -0,0 +1,325 @@
+package acmeaccounts
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &acmeAccountResource{}
+	_ resource.ResourceWithConfigure      = &acmeAccountResource{}
+	_ resource.ResourceWithImportState    = &acmeAccountResource{}
+	_ resource.ResourceWithValidateConfig = &acmeAccountResource{}
+	_ resource.ResourceWithModifyPlan     = &acmeAccountResource{}
+)
+
+// AcmeAccountResource is a helper function to simplify the provider implementation.
+func AcmeAccountResource() resource.Resource {
+	return &acmeAccountResource{}
+}
+
+// acmeAccountResource is the resource implementation.
+type acmeAccountResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+	serverVersion  string
+}
+
+type acmeAccountResourceModel struct {
+	Id                     types.String   `tfsdk:"id"`
+	AcmeServerId           types.String   `tfsdk:"acme_server_id"`
+	KeyAlgorithm           types.String   `tfsdk:"key_algorithm"`
+	Contacts               types.List     `tfsdk:"contacts"`
+	TermsOfServiceAccepted types.Bool     `tfsdk:"terms_of_service_accepted"`
+	Url                    types.String   `tfsdk:"url"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *acmeAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	acmeAccountResourceSchema(ctx, req, resp)
+}
+
+func acmeAccountResourceSchema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Manages an account with an ACME Server. ACME accounts can't be updated, so every change creates a new account.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"acme_server_id": schema.StringAttribute{
+				Description: "ID of the ACME Server to register the account with.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_algorithm": schema.StringAttribute{
+				Description: "Algorithm of the key used to sign requests to the ACME Server, either RSA or EC.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"contacts": schema.ListAttribute{
+				Description: "Contact URLs for the account, such as mailto:admin@example.com.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"terms_of_service_accepted": schema.BoolAttribute{
+				Description: "Whether the terms of service of the ACME Server are accepted. Must be true.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "URL of the account on the ACME Server.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Delete: true,
+	})
+	resp.Schema = schema
+}
+
+// Metadata returns the resource type name.
+func (r *acmeAccountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acme_account"
+}
+
+func (r *acmeAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+	r.serverVersion = providerCfg.ServerVersion
+
+}
+
+func (r *acmeAccountResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var termsOfServiceAccepted types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("terms_of_service_accepted"), &termsOfServiceAccepted)...)
+	if internaltypes.IsDefined(termsOfServiceAccepted) && !termsOfServiceAccepted.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("terms_of_service_accepted"), "Terms of service not accepted",
+			"ACME Servers only create accounts once their terms of service are accepted")
+	}
+}
+
+func readAcmeAccountResponse(ctx context.Context, r *client.AcmeAccount, state *acmeAccountResourceModel, expectedValues *acmeAccountResourceModel, diagnostics *diag.Diagnostics) {
+	state.Id = types.StringValue(*r.Id)
+	state.AcmeServerId = expectedValues.AcmeServerId
+	state.KeyAlgorithm = types.StringValue(r.KeyAlgorithm)
+	if len(r.Contacts) == 0 && expectedValues.Contacts.IsNull() {
+		state.Contacts = types.ListNull(types.StringType)
+	} else {
+		contacts, diags := types.ListValueFrom(ctx, types.StringType, r.Contacts)
+		diagnostics.Append(diags...)
+		state.Contacts = contacts
+	}
+	// Only accepted terms are stored by the ACME Server
+	state.TermsOfServiceAccepted = types.BoolValue(r.GetTermsOfServiceAccepted() || expectedValues.TermsOfServiceAccepted.ValueBool())
+	state.Url = internaltypes.StringTypeOrNil(r.Url, false)
+}
+
+func (r *acmeAccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying the resource
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	config.CheckServerVersion(&resp.Diagnostics, r.serverVersion, config.AcmeMinimumVersion, "pingaccess_acme_account")
+}
+
+func (r *acmeAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan acmeAccountResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	createAcmeAccount := client.NewAcmeAccount(plan.KeyAlgorithm.ValueString())
+	createAcmeAccount.AcmeServerId = plan.AcmeServerId.ValueStringPointer()
+	createAcmeAccount.TermsOfServiceAccepted = plan.TermsOfServiceAccepted.ValueBoolPointer()
+	if internaltypes.IsDefined(plan.Contacts) {
+		var contacts []string
+		resp.Diagnostics.Append(plan.Contacts.ElementsAs(ctx, &contacts, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		createAcmeAccount.Contacts = contacts
+	}
+	requestJson, err := createAcmeAccount.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add request: "+string(requestJson))
+	}
+
+	acmeAccountResponse, httpResp, err := r.apiClient.AcmeApi.AddAcmeAccount(config.AuthContext(ctx, r.providerConfig), plan.AcmeServerId.ValueString()).AcmeAccount(*createAcmeAccount).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the ACME Account", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
+	responseJson, err := acmeAccountResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	var state acmeAccountResourceModel
+
+	readAcmeAccountResponse(ctx, acmeAccountResponse, &state, &plan, &resp.Diagnostics)
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *acmeAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readAcmeAccount(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func readAcmeAccount(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	var state acmeAccountResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	apiReadAcmeAccount, httpResp, err := apiClient.AcmeApi.GetAcmeAccount(config.AuthContext(ctx, providerConfig), state.AcmeServerId.ValueString(), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "ACME Account", httpResp) {
+		return
+	}
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an ACME Account", err, httpResp)
+		return
+	}
+	// Log response JSON
+	responseJson, err := apiReadAcmeAccount.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	readAcmeAccountResponse(ctx, apiReadAcmeAccount, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *acmeAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateAcmeAccount(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func updateAcmeAccount(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Every configurable attribute requires replacement, so only the timeouts can change here
+	var plan, state acmeAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = plan.Timeouts
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// // Delete deletes the resource and removes the Terraform state on success.
+func (r *acmeAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	deleteAcmeAccount(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+func deleteAcmeAccount(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from state
+	var state acmeAccountResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	_, httpResp, err := apiClient.AcmeApi.DeleteAcmeAccount(config.AuthContext(ctx, providerConfig), state.AcmeServerId.ValueString(), state.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting an ACME Account", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
+
+}
+
+func (r *acmeAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Accounts are nested under their ACME Server, so the import ID is <acme_server_id>/<id>
+	acmeServerId, id, found := strings.Cut(req.ID, "/")
+	if !found || acmeServerId == "" || id == "" {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected an import ID of the form <acme_server_id>/<id>, got \""+req.ID+"\"")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("acme_server_id"), acmeServerId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
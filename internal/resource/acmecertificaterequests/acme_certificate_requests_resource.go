@@ -0,0 +1,409 @@
+package acmecertificaterequests
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &acmeCertificateRequestResource{}
+	_ resource.ResourceWithConfigure   = &acmeCertificateRequestResource{}
+	_ resource.ResourceWithImportState = &acmeCertificateRequestResource{}
+	_ resource.ResourceWithModifyPlan  = &acmeCertificateRequestResource{}
+)
+
+// States of a certificate request that won't change any more
+const (
+	acmeCertificateRequestValid   = "VALID"
+	acmeCertificateRequestInvalid = "INVALID"
+)
+
+// How often to check on a pending certificate request
+const acmeCertificateRequestPollInterval = 5 * time.Second
+
+// AcmeCertificateRequestResource is a helper function to simplify the provider implementation.
+func AcmeCertificateRequestResource() resource.Resource {
+	return &acmeCertificateRequestResource{}
+}
+
+// acmeCertificateRequestResource is the resource implementation.
+type acmeCertificateRequestResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+	serverVersion  string
+}
+
+type acmeCertificateRequestResourceModel struct {
+	Id            types.String   `tfsdk:"id"`
+	AcmeServerId  types.String   `tfsdk:"acme_server_id"`
+	AcmeAccountId types.String   `tfsdk:"acme_account_id"`
+	KeypairId     types.String   `tfsdk:"keypair_id"`
+	Url           types.String   `tfsdk:"url"`
+	Status        types.String   `tfsdk:"status"`
+	Problems      types.List     `tfsdk:"problems"`
+	Certificate   types.String   `tfsdk:"certificate"`
+	SerialNumber  types.String   `tfsdk:"serial_number"`
+	IssuerDn      types.String   `tfsdk:"issuer_dn"`
+	Expires       types.String   `tfsdk:"expires"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *acmeCertificateRequestResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	acmeCertificateRequestResourceSchema(ctx, req, resp)
+}
+
+func acmeCertificateRequestResourceSchema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Requests a certificate for a Key Pair from an ACME Server, and waits for it to be issued. The issued certificate is imported into the Key Pair.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"acme_server_id": schema.StringAttribute{
+				Description: "ID of the ACME Server to request the certificate from.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"acme_account_id": schema.StringAttribute{
+				Description: "ID of the ACME Account to request the certificate with.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keypair_id": schema.StringAttribute{
+				Description: "ID of the Key Pair to request a certificate for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "URL of the order on the ACME Server.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the certificate request, such as PENDING, VALID or INVALID.",
+				Computed:    true,
+			},
+			"problems": schema.ListAttribute{
+				Description: "Problems reported by the ACME Server for the certificate request.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"certificate": schema.StringAttribute{
+				Description: "PEM-encoded certificate issued for the Key Pair.",
+				Computed:    true,
+			},
+			"serial_number": schema.StringAttribute{
+				Description: "Serial number of the issued certificate.",
+				Computed:    true,
+			},
+			"issuer_dn": schema.StringAttribute{
+				Description: "Issuer DN of the issued certificate.",
+				Computed:    true,
+			},
+			"expires": schema.StringAttribute{
+				Description: "End of the validity period of the issued certificate, in RFC 3339 format.",
+				Computed:    true,
+			},
+		},
+	}
+
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Delete: true,
+	})
+	resp.Schema = schema
+}
+
+// Metadata returns the resource type name.
+func (r *acmeCertificateRequestResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acme_certificate_request"
+}
+
+func (r *acmeCertificateRequestResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+	r.serverVersion = providerCfg.ServerVersion
+
+}
+
+func readAcmeCertificateRequestResponse(ctx context.Context, r *client.AcmeCertificateRequest, state *acmeCertificateRequestResourceModel, expectedValues *acmeCertificateRequestResourceModel, diagnostics *diag.Diagnostics) {
+	state.Id = types.StringValue(*r.Id)
+	state.AcmeServerId = expectedValues.AcmeServerId
+	state.AcmeAccountId = expectedValues.AcmeAccountId
+	state.KeypairId = types.StringValue(internaltypes.Int64PointerToString(r.KeyPairId))
+	state.Url = internaltypes.StringTypeOrNil(r.Url, false)
+	state.Status = types.StringNull()
+	if r.AcmeCertStatus != nil {
+		state.Status = internaltypes.StringTypeOrNil(r.AcmeCertStatus.State, false)
+	}
+	problems, diags := types.ListValueFrom(ctx, types.StringType, r.AcmeCertStatus.GetProblems())
+	diagnostics.Append(diags...)
+	state.Problems = problems
+	state.Certificate = types.StringNull()
+	state.SerialNumber = types.StringNull()
+	state.IssuerDn = types.StringNull()
+	state.Expires = types.StringNull()
+}
+
+// Read the certificate issued for a valid request from its Key Pair
+func readIssuedCertificate(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, state *acmeCertificateRequestResourceModel, diagnostics *diag.Diagnostics) {
+	if state.Status.ValueString() != acmeCertificateRequestValid {
+		return
+	}
+	keyPairId := state.KeypairId.ValueString()
+	apiReadKeyPair, httpResp, err := apiClient.KeyPairsApi.GetKeyPair(config.AuthContext(ctx, providerConfig), keyPairId).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diagnostics, "An error occurred while looking for the Key Pair of an ACME certificate request", err, httpResp)
+		return
+	}
+	certificate, httpResp, err := apiClient.KeyPairsApi.ExportKeyPairCert(config.AuthContext(ctx, providerConfig), keyPairId).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diagnostics, "An error occurred while exporting the certificate of a Key Pair", err, httpResp)
+		return
+	}
+	state.Certificate = types.StringValue(certificate)
+	state.SerialNumber = internaltypes.StringTypeOrNil(apiReadKeyPair.SerialNumber, false)
+	state.IssuerDn = internaltypes.StringTypeOrNil(apiReadKeyPair.IssuerDn, false)
+	state.Expires = internaltypes.TimestampTypeOrNil(apiReadKeyPair.Expires)
+}
+
+// Poll the certificate request until the ACME Server has either issued the certificate or rejected the request
+func waitForAcmeCertificateRequest(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, request *client.AcmeCertificateRequest, acmeServerId, acmeAccountId string, diagnostics *diag.Diagnostics) *client.AcmeCertificateRequest {
+	for {
+		state := request.AcmeCertStatus.GetState()
+		switch state {
+		case acmeCertificateRequestValid:
+			return request
+		case acmeCertificateRequestInvalid:
+			diagnostics.AddError("ACME certificate request failed", "The ACME Server rejected the certificate request: "+strings.Join(request.AcmeCertStatus.GetProblems(), "; "))
+			return request
+		}
+		tflog.Debug(ctx, "Waiting for ACME certificate request", map[string]interface{}{
+			"id":    *request.Id,
+			"state": state,
+		})
+		timer := time.NewTimer(acmeCertificateRequestPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			diagnostics.AddError("Timed out waiting for ACME certificate request",
+				"The certificate request is still in state "+state+". Increase the create timeout to wait longer.")
+			return request
+		case <-timer.C:
+		}
+		apiReadRequest, httpResp, err := apiClient.AcmeApi.GetAcmeCertificateRequest(config.AuthContext(ctx, providerConfig), acmeServerId, acmeAccountId, *request.Id).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, diagnostics, "An error occurred while checking on an ACME certificate request", err, httpResp)
+			return request
+		}
+		request = apiReadRequest
+	}
+}
+
+func (r *acmeCertificateRequestResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying the resource
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	config.CheckServerVersion(&resp.Diagnostics, r.serverVersion, config.AcmeMinimumVersion, "pingaccess_acme_certificate_request")
+}
+
+func (r *acmeCertificateRequestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan acmeCertificateRequestResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	keyPairId, err := strconv.ParseInt(plan.KeypairId.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("keypair_id"), "Invalid Key Pair ID", "keypair_id must be the numeric ID of a Key Pair, got "+plan.KeypairId.ValueString())
+		return
+	}
+	createRequest := client.NewAcmeCertificateRequest(keyPairId)
+	requestJson, err := createRequest.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add request: "+string(requestJson))
+	}
+
+	acmeServerId := plan.AcmeServerId.ValueString()
+	acmeAccountId := plan.AcmeAccountId.ValueString()
+	requestResponse, httpResp, err := r.apiClient.AcmeApi.AddAcmeCertificateRequest(config.AuthContext(ctx, r.providerConfig), acmeServerId, acmeAccountId).AcmeCertificateRequest(*createRequest).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the ACME certificate request", err, httpResp)
+		return
+	}
+	responseJson, err := requestResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add response: "+string(responseJson))
+	}
+
+	// Track the request in state even if it fails, so that it is cleaned up on destroy
+	requestResponse = waitForAcmeCertificateRequest(ctx, r.apiClient, r.providerConfig, requestResponse, acmeServerId, acmeAccountId, &resp.Diagnostics)
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
+
+	// Read the response into the state
+	var state acmeCertificateRequestResourceModel
+
+	readAcmeCertificateRequestResponse(ctx, requestResponse, &state, &plan, &resp.Diagnostics)
+	readIssuedCertificate(ctx, r.apiClient, r.providerConfig, &state, &resp.Diagnostics)
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *acmeCertificateRequestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readAcmeCertificateRequest(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func readAcmeCertificateRequest(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	var state acmeCertificateRequestResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	apiReadRequest, httpResp, err := apiClient.AcmeApi.GetAcmeCertificateRequest(config.AuthContext(ctx, providerConfig), state.AcmeServerId.ValueString(), state.AcmeAccountId.ValueString(), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "ACME certificate request", httpResp) {
+		return
+	}
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an ACME certificate request", err, httpResp)
+		return
+	}
+	// Log response JSON
+	responseJson, err := apiReadRequest.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	readAcmeCertificateRequestResponse(ctx, apiReadRequest, &state, &state, &resp.Diagnostics)
+	readIssuedCertificate(ctx, apiClient, providerConfig, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *acmeCertificateRequestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateAcmeCertificateRequest(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func updateAcmeCertificateRequest(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Every configurable attribute requires replacement, so only the timeouts can change here
+	var plan, state acmeCertificateRequestResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = plan.Timeouts
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// // Delete deletes the resource and removes the Terraform state on success.
+func (r *acmeCertificateRequestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	deleteAcmeCertificateRequest(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+func deleteAcmeCertificateRequest(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from state
+	var state acmeCertificateRequestResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// The issued certificate stays in the Key Pair
+	_, httpResp, err := apiClient.AcmeApi.DeleteAcmeCertificateRequest(config.AuthContext(ctx, providerConfig), state.AcmeServerId.ValueString(), state.AcmeAccountId.ValueString(), state.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting an ACME certificate request", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
+
+}
+
+func (r *acmeCertificateRequestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Certificate requests are nested under their ACME Server and Account, so the import ID is
+	// <acme_server_id>/<acme_account_id>/<id>
+	ids := strings.Split(req.ID, "/")
+	if len(ids) != 3 || ids[0] == "" || ids[1] == "" || ids[2] == "" {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected an import ID of the form <acme_server_id>/<acme_account_id>/<id>, got \""+req.ID+"\"")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("acme_server_id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("acme_account_id"), ids[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ids[2])...)
+}
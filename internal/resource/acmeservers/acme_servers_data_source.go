@@ -0,0 +1,138 @@
+package acmeservers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &acmeServerDataSource{}
+	_ datasource.DataSourceWithConfigure = &acmeServerDataSource{}
+)
+
+// AcmeServerDataSource is a helper function to simplify the provider implementation.
+func AcmeServerDataSource() datasource.DataSource {
+	return &acmeServerDataSource{}
+}
+
+// acmeServerDataSource is the data source implementation.
+type acmeServerDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type acmeServerDataSourceModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Url  types.String `tfsdk:"url"`
+}
+
+// Schema defines the schema for the data source.
+func (d *acmeServerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an ACME Server by id or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the ACME Server. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Exact name of the ACME Server. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"url": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *acmeServerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acme_server"
+}
+
+func (d *acmeServerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	d.providerConfig = providerCfg.ProviderConfig
+	d.apiClient = providerCfg.ApiClient
+}
+
+func (d *acmeServerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state acmeServerDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.CheckDataSourceLookup(&resp.Diagnostics, state.Id, state.Name, "name") {
+		return
+	}
+
+	var acmeServer *client.AcmeServer
+	if internaltypes.IsDefined(state.Id) {
+		apiReadAcmeServer, httpResp, err := d.apiClient.AcmeApi.GetAcmeServer(config.AuthContext(ctx, d.providerConfig), state.Id.ValueString()).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an ACME Server", err, httpResp)
+			return
+		}
+		acmeServer = apiReadAcmeServer
+	} else {
+		name := state.Name.ValueString()
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.AcmeServer, *http.Response, error) {
+			apiReadAcmeServers, httpResp, err := d.apiClient.AcmeApi.GetAcmeServers(config.AuthContext(ctx, d.providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadAcmeServers.GetItems(), httpResp, nil
+		})
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an ACME Server", err, httpResp)
+			return
+		}
+		// Only exact matches count
+		var matches []client.AcmeServer
+		for _, item := range items {
+			if item.Name == name {
+				matches = append(matches, item)
+			}
+		}
+		if !config.CheckLookupMatches(&resp.Diagnostics, "ACME Server", "name \""+name+"\"", len(matches)) {
+			return
+		}
+		acmeServer = &matches[0]
+	}
+	// Log response JSON
+	responseJson, err := acmeServer.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response using the resource mapper
+	var model acmeserversResourceModel
+	readAcmeServerResponse(ctx, acmeServer, &model, &model, &resp.Diagnostics)
+	state = acmeServerDataSourceModel{
+		Id:   model.Id,
+		Name: model.Name,
+		Url:  model.Url,
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -2,9 +2,10 @@ package acmeservers
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -35,9 +36,10 @@ type acmeserversResource struct {
 }
 
 type acmeserversResourceModel struct {
-	Id   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
-	Url  types.String `tfsdk:"url"`
+	Id       types.String   `tfsdk:"id"`
+	Name     types.String   `tfsdk:"name"`
+	Url      types.String   `tfsdk:"url"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -76,6 +78,11 @@ func acmeserversResourceSchema(ctx context.Context, req resource.SchemaRequest,
 	if setOptionalToComputed {
 		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"name", "url"})
 	}
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Delete: true,
+	})
 	resp.Schema = schema
 }
 func addOptionalAcmeServerFields(ctx context.Context, addRequest *client.AcmeServer, plan acmeserversResourceModel) error {
@@ -116,6 +123,13 @@ func (r *acmeserversResource) Create(ctx context.Context, req resource.CreateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	createAcmeServer := client.NewAcmeServer(plan.Name.ValueString(), plan.Url.ValueString())
 	err := addOptionalAcmeServerFields(ctx, createAcmeServer, plan)
@@ -128,13 +142,14 @@ func (r *acmeserversResource) Create(ctx context.Context, req resource.CreateReq
 		tflog.Debug(ctx, "Add request: "+string(requestJson))
 	}
 
-	apiCreateAcmeServer := r.apiClient.AcmeApi.AddAcmeServer(config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiCreateAcmeServer := r.apiClient.AcmeApi.AddAcmeServer(config.AuthContext(ctx, r.providerConfig))
 	apiCreateAcmeServer = apiCreateAcmeServer.AcmeServer(*createAcmeServer)
 	listenerResponse, httpResp, err := r.apiClient.AcmeApi.AddAcmeServerExecute(apiCreateAcmeServer)
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the AcmeServer", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
 	responseJson, err := listenerResponse.MarshalJSON()
 	if err == nil {
 		tflog.Debug(ctx, "Add response: "+string(responseJson))
@@ -144,6 +159,7 @@ func (r *acmeserversResource) Create(ctx context.Context, req resource.CreateReq
 	var state acmeserversResourceModel
 
 	readAcmeServerResponse(ctx, listenerResponse, &state, &plan, &resp.Diagnostics)
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -163,8 +179,17 @@ func readAcmeServer(ctx context.Context, req resource.ReadRequest, resp *resourc
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	apiReadAcmeServer, httpResp, err := apiClient.AcmeApi.GetAcmeServer(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
-
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	apiReadAcmeServer, httpResp, err := apiClient.AcmeApi.GetAcmeServer(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "ACME Server", httpResp) {
+		return
+	}
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an AcmeServer", err, httpResp)
 		return
@@ -208,19 +233,42 @@ func deleteAcmeServer(ctx context.Context, req resource.DeleteRequest, resp *res
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
-	_, httpResp, err := apiClient.AcmeApi.DeleteAcmeServer(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	_, httpResp, err := apiClient.AcmeApi.DeleteAcmeServer(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting an AcmeServer", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 
 }
 
 func (r *acmeserversResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	importLocation(ctx, req, resp)
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
 }
-func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve import ID and save to id attribute, looking the ID up first when importing by name
+	config.ImportByIdOrName(ctx, req, resp, "ACME Server", func(name string) ([]string, *http.Response, error) {
+		var ids []string
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.AcmeServer, *http.Response, error) {
+			apiReadAcmeServers, httpResp, err := apiClient.AcmeApi.GetAcmeServers(config.AuthContext(ctx, providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadAcmeServers.GetItems(), httpResp, nil
+		})
+		for i := range items {
+			if items[i].Name == name {
+				ids = append(ids, *items[i].Id)
+			}
+		}
+		return ids, httpResp, err
+	})
 }
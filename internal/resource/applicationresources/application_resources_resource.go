@@ -0,0 +1,547 @@
+package applicationresources
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &applicationResourceResource{}
+	_ resource.ResourceWithConfigure      = &applicationResourceResource{}
+	_ resource.ResourceWithImportState    = &applicationResourceResource{}
+	_ resource.ResourceWithValidateConfig = &applicationResourceResource{}
+)
+
+// Path pattern types
+const (
+	pathPatternWildcard = "WILDCARD"
+	pathPatternRegex    = "REGEX"
+)
+
+// ApplicationResourceResource is a helper function to simplify the provider implementation.
+func ApplicationResourceResource() resource.Resource {
+	return &applicationResourceResource{}
+}
+
+// applicationResourceResource is the resource implementation.
+type applicationResourceResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type applicationResourceResourceModel struct {
+	Id                      types.String   `tfsdk:"id"`
+	ApplicationId           types.Int64    `tfsdk:"application_id"`
+	Name                    types.String   `tfsdk:"name"`
+	Methods                 types.Set      `tfsdk:"methods"`
+	PathPatterns            types.List     `tfsdk:"path_patterns"`
+	Priority                types.Int64    `tfsdk:"priority"`
+	Anonymous               types.Bool     `tfsdk:"anonymous"`
+	Unprotected             types.Bool     `tfsdk:"unprotected"`
+	AuditLevel              types.String   `tfsdk:"audit_level"`
+	DefaultAuthTypeOverride types.String   `tfsdk:"default_auth_type_override"`
+	Enabled                 types.Bool     `tfsdk:"enabled"`
+	Policy                  types.Object   `tfsdk:"policy"`
+	RootResource            types.Bool     `tfsdk:"root_resource"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+type pathPatternModel struct {
+	Pattern types.String `tfsdk:"pattern"`
+	Type    types.String `tfsdk:"type"`
+}
+
+// Attribute types of the path_patterns elements
+var pathPatternAttrTypes = map[string]attr.Type{
+	"pattern": types.StringType,
+	"type":    types.StringType,
+}
+
+// GetSchema defines the schema for the resource.
+func (r *applicationResourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	applicationResourceResourceSchema(ctx, req, resp)
+}
+
+func applicationResourceResourceSchema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Manages a Resource of an Application.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_id": schema.Int64Attribute{
+				Description: "ID of the Application the Resource belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"methods": schema.SetAttribute{
+				Description: "HTTP methods the Resource matches, or * for all methods.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"path_patterns": schema.ListNestedAttribute{
+				Description: "Patterns of the request paths the Resource matches, relative to the Application's context root.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"pattern": schema.StringAttribute{
+							Description: "Path pattern, such as /api/* or /api/v[0-9]+/.*.",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the pattern, either WILDCARD or REGEX.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Description: "Priority of the Resource when its REGEX path patterns overlap with those of other Resources. Lower values are evaluated first.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"anonymous": schema.BoolAttribute{
+				Description: "Whether requests to the Resource are allowed without authentication, while still applying its policy.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"unprotected": schema.BoolAttribute{
+				Description: "Whether requests to the Resource bypass authentication and policy.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"audit_level": schema.StringAttribute{
+				Description: "Whether requests to the Resource are audited, either ON or OFF.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_auth_type_override": schema.StringAttribute{
+				Description: "Authentication type of the Resource when it differs from the Application's, either Web or API.",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the Resource is enabled.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy": config.PolicyAttribute("Rules and Rule Sets applied to requests to the Resource."),
+			"root_resource": schema.BoolAttribute{
+				Description: "Whether this is the Application's default Resource, which matches requests no other Resource does. PingAccess creates it along with the Application, so it is adopted on create and only removed from state on destroy.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+	resp.Schema = schema
+}
+
+func addOptionalApplicationResourceFields(ctx context.Context, addRequest *client.Resource, plan applicationResourceResourceModel) error {
+	addRequest.ApplicationId = plan.ApplicationId.ValueInt64Pointer()
+	var pathPatterns []pathPatternModel
+	plan.PathPatterns.ElementsAs(ctx, &pathPatterns, false)
+	addRequest.PathPatterns = []client.PathPattern{}
+	for _, pathPattern := range pathPatterns {
+		addRequest.PathPatterns = append(addRequest.PathPatterns, client.PathPattern{
+			Pattern: pathPattern.Pattern.ValueStringPointer(),
+			Type:    pathPattern.Type.ValueStringPointer(),
+		})
+	}
+	if internaltypes.IsDefined(plan.Priority) {
+		addRequest.Priority = plan.Priority.ValueInt64Pointer()
+	}
+	if internaltypes.IsDefined(plan.Anonymous) {
+		addRequest.Anonymous = plan.Anonymous.ValueBoolPointer()
+	}
+	if internaltypes.IsDefined(plan.Unprotected) {
+		addRequest.Unprotected = plan.Unprotected.ValueBoolPointer()
+	}
+	if internaltypes.IsNonEmptyString(plan.AuditLevel) {
+		addRequest.AuditLevel = plan.AuditLevel.ValueStringPointer()
+	}
+	if internaltypes.IsNonEmptyString(plan.DefaultAuthTypeOverride) {
+		addRequest.DefaultAuthTypeOverride = plan.DefaultAuthTypeOverride.ValueStringPointer()
+	}
+	if internaltypes.IsDefined(plan.Enabled) {
+		addRequest.Enabled = plan.Enabled.ValueBoolPointer()
+	}
+	if internaltypes.IsDefined(plan.Policy) {
+		addRequest.Policy = config.GetPolicyRequest(ctx, plan.Policy)
+	}
+	if internaltypes.IsDefined(plan.RootResource) {
+		addRequest.RootResource = plan.RootResource.ValueBoolPointer()
+	}
+	return nil
+}
+
+// Metadata returns the resource type name.
+func (r *applicationResourceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_resource"
+}
+
+func (r *applicationResourceResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+
+}
+
+func (r *applicationResourceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model applicationResourceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() || !internaltypes.IsDefined(model.PathPatterns) {
+		return
+	}
+	var pathPatterns []pathPatternModel
+	resp.Diagnostics.Append(model.PathPatterns.ElementsAs(ctx, &pathPatterns, false)...)
+	hasRegex := false
+	for i, pathPattern := range pathPatterns {
+		if pathPattern.Type.IsUnknown() {
+			// Can't tell yet whether a priority is allowed
+			hasRegex = true
+			continue
+		}
+		switch pathPattern.Type.ValueString() {
+		case pathPatternWildcard:
+		case pathPatternRegex:
+			hasRegex = true
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("path_patterns").AtListIndex(i).AtName("type"), "Invalid path pattern type",
+				"Path pattern type must be either "+pathPatternWildcard+" or "+pathPatternRegex)
+		}
+	}
+	if internaltypes.IsDefined(model.Priority) && !hasRegex {
+		resp.Diagnostics.AddAttributeError(path.Root("priority"), "Invalid Resource priority", "priority only applies to Resources with "+pathPatternRegex+" path patterns")
+	}
+}
+
+func readApplicationResourceResponse(ctx context.Context, r *client.Resource, state *applicationResourceResourceModel, expectedValues *applicationResourceResourceModel, diagnostics *diag.Diagnostics) {
+	state.Id = types.StringValue(internaltypes.Int64PointerToString(*r.Id))
+	state.ApplicationId = internaltypes.Int64TypeOrNil(r.ApplicationId)
+	state.Name = types.StringValue(r.Name)
+	state.Methods = internaltypes.GetStringSet(r.Methods)
+	pathPatterns := []attr.Value{}
+	for _, pathPattern := range r.PathPatterns {
+		pathPatternValue, diags := types.ObjectValue(pathPatternAttrTypes, map[string]attr.Value{
+			"pattern": internaltypes.StringTypeOrNil(pathPattern.Pattern, false),
+			"type":    internaltypes.StringTypeOrNil(pathPattern.Type, false),
+		})
+		diagnostics.Append(diags...)
+		pathPatterns = append(pathPatterns, pathPatternValue)
+	}
+	pathPatternList, diags := types.ListValue(types.ObjectType{AttrTypes: pathPatternAttrTypes}, pathPatterns)
+	diagnostics.Append(diags...)
+	state.PathPatterns = pathPatternList
+	state.Priority = internaltypes.Int64TypeOrNil(r.Priority)
+	state.Anonymous = internaltypes.BoolTypeOrNil(r.Anonymous)
+	state.Unprotected = internaltypes.BoolTypeOrNil(r.Unprotected)
+	state.AuditLevel = internaltypes.StringTypeOrNil(r.AuditLevel, false)
+	state.DefaultAuthTypeOverride = internaltypes.StringTypeOrNil(r.DefaultAuthTypeOverride, internaltypes.IsNonEmptyString(expectedValues.DefaultAuthTypeOverride))
+	state.Enabled = internaltypes.BoolTypeOrNil(r.Enabled)
+	state.Policy = config.GetPolicy(r.Policy, diagnostics)
+	state.RootResource = types.BoolValue(r.GetRootResource())
+}
+
+// Find the default Resource PingAccess created along with the Application
+func findRootResource(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, applicationId string, diagnostics *diag.Diagnostics) *client.Resource {
+	items, httpResp, err := config.ListAllPages(func(page int32) ([]client.Resource, *http.Response, error) {
+		apiReadResources, httpResp, err := apiClient.ApplicationsApi.GetApplicationResources(config.AuthContext(ctx, providerConfig), applicationId).Page(page).NumberPerPage(config.ListPageSize).Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		return apiReadResources.GetItems(), httpResp, nil
+	})
+	if err != nil {
+		config.ReportHttpError(ctx, diagnostics, "An error occurred while looking for the default Resource of an Application", err, httpResp)
+		return nil
+	}
+	for i := range items {
+		if items[i].GetRootResource() {
+			return &items[i]
+		}
+	}
+	diagnostics.AddError("Default Resource not found", "Application "+applicationId+" has no default Resource")
+	return nil
+}
+
+func (r *applicationResourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan applicationResourceResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	var MethodsSlice []string
+	plan.Methods.ElementsAs(ctx, &MethodsSlice, false)
+	createResource := client.NewResource(MethodsSlice, plan.Name.ValueString())
+	err := addOptionalApplicationResourceFields(ctx, createResource, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add optional properties to add request for Application Resource", err.Error())
+		return
+	}
+	requestJson, err := createResource.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add request: "+string(requestJson))
+	}
+
+	applicationId := internaltypes.Int64ToString(plan.ApplicationId)
+	var resourceResponse *client.Resource
+	if plan.RootResource.ValueBool() {
+		// The default Resource already exists, so take it over
+		rootResource := findRootResource(ctx, r.apiClient, r.providerConfig, applicationId, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		apiUpdateResource := r.apiClient.ApplicationsApi.UpdateApplicationResource(config.AuthContext(ctx, r.providerConfig), applicationId, internaltypes.Int64PointerToString(*rootResource.Id))
+		updateResourceResponse, httpResp, err := apiUpdateResource.Resource(*createResource).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the default Resource of the Application", err, httpResp)
+			return
+		}
+		config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
+		resourceResponse = updateResourceResponse
+	} else {
+		apiCreateResource := r.apiClient.ApplicationsApi.AddApplicationResource(config.AuthContext(ctx, r.providerConfig), applicationId)
+		addResourceResponse, httpResp, err := apiCreateResource.Resource(*createResource).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Application Resource", err, httpResp)
+			return
+		}
+		config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
+		resourceResponse = addResourceResponse
+	}
+	responseJson, err := resourceResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	var state applicationResourceResourceModel
+
+	readApplicationResourceResponse(ctx, resourceResponse, &state, &plan, &resp.Diagnostics)
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *applicationResourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readApplicationResource(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func readApplicationResource(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	var state applicationResourceResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	apiReadResource, httpResp, err := apiClient.ApplicationsApi.GetApplicationResource(config.AuthContext(ctx, providerConfig), internaltypes.Int64ToString(state.ApplicationId), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "Application Resource", httpResp) {
+		return
+	}
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an Application Resource", err, httpResp)
+		return
+	}
+	// Log response JSON
+	responseJson, err := apiReadResource.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	readApplicationResourceResponse(ctx, apiReadResource, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *applicationResourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateApplicationResource(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func updateApplicationResource(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from plan
+	var plan applicationResourceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Get the current state to see how any attributes are changing
+	var state applicationResourceResourceModel
+	req.State.Get(ctx, &state)
+	var MethodsSlice []string
+	plan.Methods.ElementsAs(ctx, &MethodsSlice, false)
+	UpdateResource := apiClient.ApplicationsApi.UpdateApplicationResource(config.AuthContext(ctx, providerConfig), internaltypes.Int64ToString(plan.ApplicationId), plan.Id.ValueString())
+	CreateUpdateRequest := client.NewResource(MethodsSlice, plan.Name.ValueString())
+	err := addOptionalApplicationResourceFields(ctx, CreateUpdateRequest, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add optional properties to add request for Application Resource", err.Error())
+		return
+	}
+	requestJson, err := CreateUpdateRequest.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Update request: "+string(requestJson))
+	}
+	UpdateResource = UpdateResource.Resource(*CreateUpdateRequest)
+	updateResourceResponse, httpResp, err := apiClient.ApplicationsApi.UpdateApplicationResourceExecute(UpdateResource)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating Application Resource", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
+	// Log response JSON
+	responseJson, err := updateResourceResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+	// Read the response
+	readApplicationResourceResponse(ctx, updateResourceResponse, &state, &plan, &resp.Diagnostics)
+
+	// Update computed values
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+// // Delete deletes the resource and removes the Terraform state on success.
+func (r *applicationResourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	deleteApplicationResource(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+func deleteApplicationResource(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from state
+	var state applicationResourceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The default Resource lives as long as its Application
+	if state.RootResource.ValueBool() {
+		tflog.Warn(ctx, "Removing the default Resource of Application "+internaltypes.Int64ToString(state.ApplicationId)+" from state. It is deleted along with the Application.")
+		return
+	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	httpResp, err := apiClient.ApplicationsApi.DeleteApplicationResource(config.AuthContext(ctx, providerConfig), internaltypes.Int64ToString(state.ApplicationId), state.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting an Application Resource", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
+
+}
+
+func (r *applicationResourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Resources are nested under their Application, so the import ID is <application_id>/<id>
+	applicationId, id, found := strings.Cut(req.ID, "/")
+	applicationIdValue, err := strconv.ParseInt(applicationId, 10, 64)
+	if !found || err != nil || id == "" {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected an import ID of the form <application_id>/<id>, got \""+req.ID+"\"")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), applicationIdValue)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
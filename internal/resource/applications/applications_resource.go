@@ -0,0 +1,549 @@
+package applications
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &applicationResource{}
+	_ resource.ResourceWithConfigure      = &applicationResource{}
+	_ resource.ResourceWithImportState    = &applicationResource{}
+	_ resource.ResourceWithValidateConfig = &applicationResource{}
+)
+
+// Application destinations
+const (
+	destinationSite  = "Site"
+	destinationAgent = "Agent"
+)
+
+// ApplicationResource is a helper function to simplify the provider implementation.
+func ApplicationResource() resource.Resource {
+	return &applicationResource{}
+}
+
+// applicationResource is the resource implementation.
+type applicationResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type applicationResourceModel struct {
+	Id                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Description        types.String   `tfsdk:"description"`
+	ContextRoot        types.String   `tfsdk:"context_root"`
+	VirtualHostIds     types.Set      `tfsdk:"virtual_host_ids"`
+	ApplicationType    types.String   `tfsdk:"application_type"`
+	Destination        types.String   `tfsdk:"destination"`
+	SiteId             types.Int64    `tfsdk:"site_id"`
+	AgentId            types.Int64    `tfsdk:"agent_id"`
+	DefaultAuthType    types.String   `tfsdk:"default_auth_type"`
+	WebSessionId       types.Int64    `tfsdk:"web_session_id"`
+	IdentityMappingIds types.Map      `tfsdk:"identity_mapping_ids"`
+	AccessValidatorId  types.Int64    `tfsdk:"access_validator_id"`
+	CaseSensitivePath  types.Bool     `tfsdk:"case_sensitive_path"`
+	Realm              types.String   `tfsdk:"realm"`
+	RequireHttps       types.Bool     `tfsdk:"require_https"`
+	SpaSupportEnabled  types.Bool     `tfsdk:"spa_support_enabled"`
+	Enabled            types.Bool     `tfsdk:"enabled"`
+	Policy             types.Object   `tfsdk:"policy"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *applicationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	applicationResourceSchema(ctx, req, resp, false)
+}
+
+func applicationResourceSchema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse, setOptionalToComputed bool) {
+	schema := schema.Schema{
+		Description: "Manages an Application.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"context_root": schema.StringAttribute{
+				Description: "Context root of the Application, which must start with a /.",
+				Required:    true,
+			},
+			"virtual_host_ids": schema.SetAttribute{
+				Description: "IDs of the Virtual Hosts the Application is served on.",
+				Required:    true,
+				ElementType: types.Int64Type,
+			},
+			"application_type": schema.StringAttribute{
+				Description: "Type of the Application, either Web, API or Dynamic.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"destination": schema.StringAttribute{
+				Description: "Where requests are sent, either Site or Agent.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.Int64Attribute{
+				Description: "ID of the Site requests are sent to. Required when destination is Site.",
+				Optional:    true,
+			},
+			"agent_id": schema.Int64Attribute{
+				Description: "ID of the Agent protecting the Application. Required when destination is Agent.",
+				Optional:    true,
+			},
+			"default_auth_type": schema.StringAttribute{
+				Description: "Authentication type used for requests that aren't matched by a Resource, either Web or API.",
+				Required:    true,
+			},
+			"web_session_id": schema.Int64Attribute{
+				Description: "ID of the Web Session used by the Application, or 0 for none.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"identity_mapping_ids": schema.MapAttribute{
+				Description: "IDs of the Identity Mappings used by the Application, keyed by Web or API.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.Int64Type,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"access_validator_id": schema.Int64Attribute{
+				Description: "ID of the Access Token Validator used by API Applications, or 0 for none.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"case_sensitive_path": schema.BoolAttribute{
+				Description: "Whether request paths are matched case-sensitively.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"realm": schema.StringAttribute{
+				Description: "OAuth realm returned in WWW-Authenticate headers by API Applications.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"require_https": schema.BoolAttribute{
+				Description: "Whether the Application only accepts HTTPS requests.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"spa_support_enabled": schema.BoolAttribute{
+				Description: "Whether single-page application support is enabled. Defaults to true, as in PingAccess.",
+				Optional:    true,
+				Computed:    true,
+				// PingAccess requires a value in every request, so default to the value it uses for new Applications
+				Default: booldefault.StaticBool(true),
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the Application is enabled.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy": config.PolicyAttribute("Rules and Rule Sets applied to requests to the Application."),
+		},
+	}
+	// Set attribtues in string list
+	if setOptionalToComputed {
+		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"name", "context_root", "virtual_host_ids", "default_auth_type"})
+	}
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+	resp.Schema = schema
+}
+
+func addOptionalApplicationFields(ctx context.Context, addRequest *client.Application, plan applicationResourceModel) error {
+	// Empty strings are treated as equivalent to null
+	if internaltypes.IsDefined(plan.Id) {
+		addRequest.Id = internaltypes.StringToInt64Pointer(plan.Id)
+	}
+	if internaltypes.IsNonEmptyString(plan.Description) {
+		addRequest.Description = plan.Description.ValueStringPointer()
+	}
+	if internaltypes.IsNonEmptyString(plan.ApplicationType) {
+		addRequest.ApplicationType = plan.ApplicationType.ValueStringPointer()
+	}
+	if internaltypes.IsNonEmptyString(plan.Destination) {
+		addRequest.Destination = plan.Destination.ValueStringPointer()
+	}
+	if internaltypes.IsDefined(plan.SiteId) {
+		addRequest.SiteId = plan.SiteId.ValueInt64Pointer()
+	}
+	if internaltypes.IsDefined(plan.AgentId) {
+		addRequest.AgentId = plan.AgentId.ValueInt64Pointer()
+	}
+	if internaltypes.IsDefined(plan.WebSessionId) {
+		addRequest.WebSessionId = plan.WebSessionId.ValueInt64Pointer()
+	}
+	if internaltypes.IsDefined(plan.IdentityMappingIds) {
+		identityMappingIds := map[string]int64{}
+		plan.IdentityMappingIds.ElementsAs(ctx, &identityMappingIds, false)
+		addRequest.IdentityMappingIds = identityMappingIds
+	}
+	if internaltypes.IsDefined(plan.AccessValidatorId) {
+		addRequest.AccessValidatorId = plan.AccessValidatorId.ValueInt64Pointer()
+	}
+	if internaltypes.IsDefined(plan.CaseSensitivePath) {
+		addRequest.CaseSensitivePath = plan.CaseSensitivePath.ValueBoolPointer()
+	}
+	if internaltypes.IsNonEmptyString(plan.Realm) {
+		addRequest.Realm = plan.Realm.ValueStringPointer()
+	}
+	if internaltypes.IsDefined(plan.RequireHttps) {
+		addRequest.RequireHTTPS = plan.RequireHttps.ValueBoolPointer()
+	}
+	if internaltypes.IsDefined(plan.Enabled) {
+		addRequest.Enabled = plan.Enabled.ValueBoolPointer()
+	}
+	if internaltypes.IsDefined(plan.Policy) {
+		addRequest.Policy = config.GetPolicyRequest(ctx, plan.Policy)
+	}
+	return nil
+}
+
+// Metadata returns the resource type name.
+func (r *applicationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application"
+}
+
+func (r *applicationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+
+}
+
+func (r *applicationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model applicationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() || model.Destination.IsUnknown() || model.SiteId.IsUnknown() || model.AgentId.IsUnknown() {
+		return
+	}
+	// Applications are sent to a Site unless the destination says otherwise
+	destination := model.Destination.ValueString()
+	if model.Destination.IsNull() {
+		destination = destinationSite
+	}
+	switch destination {
+	case destinationSite:
+		if model.SiteId.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("site_id"), "Missing Application site_id", "site_id must be set when the destination is "+destinationSite)
+		}
+		if !model.AgentId.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("agent_id"), "Invalid Application agent_id", "agent_id can only be set when the destination is "+destinationAgent)
+		}
+	case destinationAgent:
+		if model.AgentId.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("agent_id"), "Missing Application agent_id", "agent_id must be set when the destination is "+destinationAgent)
+		}
+		if !model.SiteId.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("site_id"), "Invalid Application site_id", "site_id can only be set when the destination is "+destinationSite)
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("destination"), "Invalid Application destination", "destination must be either "+destinationSite+" or "+destinationAgent)
+	}
+}
+
+func readApplicationResponse(ctx context.Context, r *client.Application, state *applicationResourceModel, expectedValues *applicationResourceModel, diagnostics *diag.Diagnostics) {
+	state.Id = types.StringValue(internaltypes.Int64PointerToString(*r.Id))
+	state.Name = types.StringValue(r.Name)
+	state.Description = internaltypes.StringTypeOrNil(r.Description, internaltypes.IsNonEmptyString(expectedValues.Description))
+	state.ContextRoot = types.StringValue(r.ContextRoot)
+	state.VirtualHostIds = internaltypes.GetInt64Set(r.VirtualHostIds)
+	state.ApplicationType = internaltypes.StringTypeOrNil(r.ApplicationType, false)
+	state.Destination = internaltypes.StringTypeOrNil(r.Destination, false)
+	// PingAccess reports the unused destination as 0
+	state.SiteId = types.Int64Null()
+	if r.GetSiteId() != 0 {
+		state.SiteId = internaltypes.Int64TypeOrNil(r.SiteId)
+	}
+	state.AgentId = types.Int64Null()
+	if r.GetAgentId() != 0 {
+		state.AgentId = internaltypes.Int64TypeOrNil(r.AgentId)
+	}
+	state.DefaultAuthType = types.StringValue(r.DefaultAuthType)
+	state.WebSessionId = internaltypes.Int64TypeOrNil(r.WebSessionId)
+	identityMappingIds, diags := types.MapValueFrom(ctx, types.Int64Type, r.IdentityMappingIds)
+	diagnostics.Append(diags...)
+	state.IdentityMappingIds = identityMappingIds
+	state.AccessValidatorId = internaltypes.Int64TypeOrNil(r.AccessValidatorId)
+	state.CaseSensitivePath = internaltypes.BoolTypeOrNil(r.CaseSensitivePath)
+	state.Realm = internaltypes.StringTypeOrNil(r.Realm, false)
+	state.RequireHttps = internaltypes.BoolTypeOrNil(r.RequireHTTPS)
+	state.SpaSupportEnabled = types.BoolValue(r.SpaSupportEnabled)
+	state.Enabled = internaltypes.BoolTypeOrNil(r.Enabled)
+	state.Policy = config.GetPolicy(r.Policy, diagnostics)
+}
+
+func (r *applicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan applicationResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	var VirtualHostIdsSlice []int64
+	plan.VirtualHostIds.ElementsAs(ctx, &VirtualHostIdsSlice, false)
+	createApplication := client.NewApplication(plan.ContextRoot.ValueString(), plan.DefaultAuthType.ValueString(), plan.Name.ValueString(), plan.SpaSupportEnabled.ValueBool(), VirtualHostIdsSlice)
+	err := addOptionalApplicationFields(ctx, createApplication, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add optional properties to add request for Application", err.Error())
+		return
+	}
+	requestJson, err := createApplication.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add request: "+string(requestJson))
+	}
+
+	apiCreateApplication := r.apiClient.ApplicationsApi.AddApplication(config.AuthContext(ctx, r.providerConfig))
+	apiCreateApplication = apiCreateApplication.Application(*createApplication)
+	applicationResponse, httpResp, err := r.apiClient.ApplicationsApi.AddApplicationExecute(apiCreateApplication)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Application", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
+	responseJson, err := applicationResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	var state applicationResourceModel
+
+	readApplicationResponse(ctx, applicationResponse, &state, &plan, &resp.Diagnostics)
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readApplication(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func readApplication(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	var state applicationResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	apiReadApplication, httpResp, err := apiClient.ApplicationsApi.GetApplication(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "Application", httpResp) {
+		return
+	}
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an Application", err, httpResp)
+		return
+	}
+	// Log response JSON
+	responseJson, err := apiReadApplication.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	readApplicationResponse(ctx, apiReadApplication, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *applicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateApplication(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func updateApplication(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from plan
+	var plan applicationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Get the current state to see how any attributes are changing
+	var state applicationResourceModel
+	req.State.Get(ctx, &state)
+	var VirtualHostIdsSlice []int64
+	plan.VirtualHostIds.ElementsAs(ctx, &VirtualHostIdsSlice, false)
+	UpdateApplication := apiClient.ApplicationsApi.UpdateApplication(config.AuthContext(ctx, providerConfig), plan.Id.ValueString())
+	CreateUpdateRequest := client.NewApplication(plan.ContextRoot.ValueString(), plan.DefaultAuthType.ValueString(), plan.Name.ValueString(), plan.SpaSupportEnabled.ValueBool(), VirtualHostIdsSlice)
+	err := addOptionalApplicationFields(ctx, CreateUpdateRequest, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add optional properties to add request for Application", err.Error())
+		return
+	}
+	requestJson, err := CreateUpdateRequest.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Update request: "+string(requestJson))
+	}
+	UpdateApplication = UpdateApplication.Application(*CreateUpdateRequest)
+	updateApplicationResponse, httpResp, err := apiClient.ApplicationsApi.UpdateApplicationExecute(UpdateApplication)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating Application", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
+	// Log response JSON
+	responseJson, err := updateApplicationResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+	// Read the response
+	readApplicationResponse(ctx, updateApplicationResponse, &state, &plan, &resp.Diagnostics)
+
+	// Update computed values
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+// // Delete deletes the resource and removes the Terraform state on success.
+func (r *applicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	deleteApplication(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+func deleteApplication(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from state
+	var state applicationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	httpResp, err := apiClient.ApplicationsApi.DeleteApplication(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting an Application", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
+
+}
+
+func (r *applicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve import ID and save to id attribute, looking the ID up first when importing by name
+	config.ImportByIdOrName(ctx, req, resp, "Application", func(name string) ([]string, *http.Response, error) {
+		var ids []string
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.Application, *http.Response, error) {
+			apiReadApplications, httpResp, err := apiClient.ApplicationsApi.GetApplications(config.AuthContext(ctx, providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadApplications.GetItems(), httpResp, nil
+		})
+		for i := range items {
+			if items[i].Name == name {
+				ids = append(ids, internaltypes.Int64PointerToString(*items[i].Id))
+			}
+		}
+		return ids, httpResp, err
+	})
+}
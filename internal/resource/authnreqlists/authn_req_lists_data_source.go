@@ -0,0 +1,139 @@
+package authnReqList
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &authnReqListDataSource{}
+	_ datasource.DataSourceWithConfigure = &authnReqListDataSource{}
+)
+
+// AuthnReqListDataSource is a helper function to simplify the provider implementation.
+func AuthnReqListDataSource() datasource.DataSource {
+	return &authnReqListDataSource{}
+}
+
+// authnReqListDataSource is the data source implementation.
+type authnReqListDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type authnReqListDataSourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	AuthnReqs types.Set    `tfsdk:"authn_reqs"`
+}
+
+// Schema defines the schema for the data source.
+func (d *authnReqListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an Authentication Requirement List by id or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the Authentication Requirement List. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Exact name of the Authentication Requirement List. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"authn_reqs": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *authnReqListDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authn_req_list"
+}
+
+func (d *authnReqListDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	d.providerConfig = providerCfg.ProviderConfig
+	d.apiClient = providerCfg.ApiClient
+}
+
+func (d *authnReqListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state authnReqListDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.CheckDataSourceLookup(&resp.Diagnostics, state.Id, state.Name, "name") {
+		return
+	}
+
+	var authnReqList *client.AuthnReqList
+	if internaltypes.IsDefined(state.Id) {
+		apiReadAuthnReqList, httpResp, err := d.apiClient.AuthnReqListsApi.GetAuthnReqList(config.AuthContext(ctx, d.providerConfig), state.Id.ValueString()).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an Authentication Requirement List", err, httpResp)
+			return
+		}
+		authnReqList = apiReadAuthnReqList
+	} else {
+		name := state.Name.ValueString()
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.AuthnReqList, *http.Response, error) {
+			apiReadAuthnReqLists, httpResp, err := d.apiClient.AuthnReqListsApi.GetAuthnReqLists(config.AuthContext(ctx, d.providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadAuthnReqLists.GetItems(), httpResp, nil
+		})
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an Authentication Requirement List", err, httpResp)
+			return
+		}
+		// Only exact matches count
+		var matches []client.AuthnReqList
+		for _, item := range items {
+			if item.Name == name {
+				matches = append(matches, item)
+			}
+		}
+		if !config.CheckLookupMatches(&resp.Diagnostics, "Authentication Requirement List", "name \""+name+"\"", len(matches)) {
+			return
+		}
+		authnReqList = &matches[0]
+	}
+	// Log response JSON
+	responseJson, err := authnReqList.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response using the resource mapper
+	var model authnReqListResourceModel
+	readAuthnReqListResponse(ctx, authnReqList, &model, &model)
+	state = authnReqListDataSourceModel{
+		Id:        model.Id,
+		Name:      model.Name,
+		AuthnReqs: model.AuthnReqs,
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
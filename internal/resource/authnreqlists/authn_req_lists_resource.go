@@ -2,8 +2,9 @@ package authnReqList
 
 import (
 	"context"
+	"net/http"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -35,9 +36,10 @@ type authnReqListResource struct {
 }
 
 type authnReqListResourceModel struct {
-	Id        types.String `tfsdk:"id"`
-	AuthnReqs types.Set    `tfsdk:"authn_reqs"`
-	Name      types.String `tfsdk:"name"`
+	Id        types.String   `tfsdk:"id"`
+	AuthnReqs types.Set      `tfsdk:"authn_reqs"`
+	Name      types.String   `tfsdk:"name"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -77,6 +79,12 @@ func authnReqListResourceSchema(ctx context.Context, req resource.SchemaRequest,
 	if setOptionalToComputed {
 		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"name", "authn_reqs"})
 	}
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
 	resp.Schema = schema
 }
 func addOptionalAuthnReqListFields(ctx context.Context, addRequest *client.AuthnReqList, plan authnReqListResourceModel) error {
@@ -118,6 +126,13 @@ func (r *authnReqListResource) Create(ctx context.Context, req resource.CreateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	var authnReqs []string
 	plan.AuthnReqs.ElementsAs(ctx, &authnReqs, false)
@@ -132,13 +147,14 @@ func (r *authnReqListResource) Create(ctx context.Context, req resource.CreateRe
 		tflog.Debug(ctx, "Add request: "+string(requestJson))
 	}
 
-	apiCreateAuthnReqList := r.apiClient.AuthnReqListsApi.AddAuthnReqList(config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiCreateAuthnReqList := r.apiClient.AuthnReqListsApi.AddAuthnReqList(config.AuthContext(ctx, r.providerConfig))
 	apiCreateAuthnReqList = apiCreateAuthnReqList.AuthnReqList(*createAuthnReqList)
 	authnReqListResponse, httpResp, err := r.apiClient.AuthnReqListsApi.AddAuthnReqListExecute(apiCreateAuthnReqList)
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the AuthnReqList", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
 	responseJson, err := authnReqListResponse.MarshalJSON()
 	if err == nil {
 		tflog.Debug(ctx, "Add response: "+string(responseJson))
@@ -148,6 +164,7 @@ func (r *authnReqListResource) Create(ctx context.Context, req resource.CreateRe
 	var state authnReqListResourceModel
 
 	readAuthnReqListResponse(ctx, authnReqListResponse, &state, &plan)
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -167,8 +184,17 @@ func readAuthnReqList(ctx context.Context, req resource.ReadRequest, resp *resou
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	apiReadAuthnReqList, httpResp, err := apiClient.AuthnReqListsApi.GetAuthnReqList(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
-
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	apiReadAuthnReqList, httpResp, err := apiClient.AuthnReqListsApi.GetAuthnReqList(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "Authn Req List", httpResp) {
+		return
+	}
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a AuthnReqList", err, httpResp)
 		return
@@ -204,13 +230,20 @@ func updateAuthnReqList(ctx context.Context, req resource.UpdateRequest, resp *r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Get the current state to see how any attributes are changing
 	var state authnReqListResourceModel
 	req.State.Get(ctx, &state)
 	var authnReqs []string
 	plan.AuthnReqs.ElementsAs(ctx, &authnReqs, false)
-	UpdateAuthnReqList := apiClient.AuthnReqListsApi.UpdateAuthnReqList(config.ProviderBasicAuthContext(ctx, providerConfig), plan.Id.ValueString())
+	UpdateAuthnReqList := apiClient.AuthnReqListsApi.UpdateAuthnReqList(config.AuthContext(ctx, providerConfig), plan.Id.ValueString())
 	CreateUpdateRequest := client.NewAuthnReqList(plan.Name.ValueString(), authnReqs)
 	err := addOptionalAuthnReqListFields(ctx, CreateUpdateRequest, plan)
 	if err != nil {
@@ -227,6 +260,7 @@ func updateAuthnReqList(ctx context.Context, req resource.UpdateRequest, resp *r
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating AuthnReqList", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 	// Log response JSON
 	responseJson, err := updateAuthnReqListResponse.MarshalJSON()
 	if err == nil {
@@ -236,6 +270,7 @@ func updateAuthnReqList(ctx context.Context, req resource.UpdateRequest, resp *r
 	readAuthnReqListResponse(ctx, updateAuthnReqListResponse, &state, &plan)
 
 	// Update computed values
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -256,18 +291,41 @@ func deleteAuthnReqList(ctx context.Context, req resource.DeleteRequest, resp *r
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	httpResp, err := apiClient.AuthnReqListsApi.DeleteAuthnReqList(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	httpResp, err := apiClient.AuthnReqListsApi.DeleteAuthnReqList(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting a AuthnReqList", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 
 }
 
 func (r *authnReqListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	importLocation(ctx, req, resp)
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
 }
-func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve import ID and save to id attribute, looking the ID up first when importing by name
+	config.ImportByIdOrName(ctx, req, resp, "Authn Req List", func(name string) ([]string, *http.Response, error) {
+		var ids []string
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.AuthnReqList, *http.Response, error) {
+			apiReadAuthnReqLists, httpResp, err := apiClient.AuthnReqListsApi.GetAuthnReqLists(config.AuthContext(ctx, providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadAuthnReqLists.GetItems(), httpResp, nil
+		})
+		for i := range items {
+			if items[i].Name == name {
+				ids = append(ids, internaltypes.Int64PointerToString(*items[i].Id))
+			}
+		}
+		return ids, httpResp, err
+	})
 }
@@ -0,0 +1,138 @@
+package certificates
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &certificateDataSource{}
+	_ datasource.DataSourceWithConfigure = &certificateDataSource{}
+)
+
+// CertificateDataSource is a helper function to simplify the provider implementation.
+func CertificateDataSource() datasource.DataSource {
+	return &certificateDataSource{}
+}
+
+// certificateDataSource is the data source implementation.
+type certificateDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type certificateDataSourceModel struct {
+	Id    types.Int64  `tfsdk:"id"`
+	Alias types.String `tfsdk:"alias"`
+}
+
+// Schema defines the schema for the data source.
+func (d *certificateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Certificate by id or alias.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "ID of the Certificate. Exactly one of id or alias must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"alias": schema.StringAttribute{
+				Description: "Exact alias of the Certificate. Exactly one of id or alias must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *certificateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+func (d *certificateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	d.providerConfig = providerCfg.ProviderConfig
+	d.apiClient = providerCfg.ApiClient
+}
+
+func (d *certificateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state certificateDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.CheckDataSourceLookup(&resp.Diagnostics, state.Id, state.Alias, "alias") {
+		return
+	}
+
+	var certificate *client.TrustedCert
+	if internaltypes.IsDefined(state.Id) {
+		apiReadCertificate, httpResp, err := d.apiClient.CertificatesApi.GetTrustedCert(config.AuthContext(ctx, d.providerConfig), internaltypes.Int64ToString(state.Id)).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Certificate", err, httpResp)
+			return
+		}
+		certificate = apiReadCertificate
+	} else {
+		alias := state.Alias.ValueString()
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.TrustedCert, *http.Response, error) {
+			apiReadTrustedCerts, httpResp, err := d.apiClient.CertificatesApi.GetTrustedCerts(config.AuthContext(ctx, d.providerConfig)).Alias(alias).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadTrustedCerts.GetItems(), httpResp, nil
+		})
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Certificate", err, httpResp)
+			return
+		}
+		// Only exact matches count
+		var matches []client.TrustedCert
+		for _, item := range items {
+			if item.Alias == alias {
+				matches = append(matches, item)
+			}
+		}
+		if !config.CheckLookupMatches(&resp.Diagnostics, "Certificate", "alias \""+alias+"\"", len(matches)) {
+			return
+		}
+		certificate = &matches[0]
+	}
+	// Log response JSON
+	responseJson, err := certificate.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	state = readCertificateDataSourceResponse(ctx, certificate, &resp.Diagnostics)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read the API response into the data source model, reusing the resource mapper
+func readCertificateDataSourceResponse(ctx context.Context, r *client.TrustedCert, diagnostics *diag.Diagnostics) certificateDataSourceModel {
+	var model certificatesResourceModel
+	readCertificateResponse(ctx, r, &model, &model, diagnostics, types.StringNull())
+	return certificateDataSourceModel{
+		Id:    model.Id,
+		Alias: model.Alias,
+	}
+}
@@ -0,0 +1,129 @@
+package certificates
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &certificatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &certificatesDataSource{}
+)
+
+// CertificatesDataSource is a helper function to simplify the provider implementation.
+func CertificatesDataSource() datasource.DataSource {
+	return &certificatesDataSource{}
+}
+
+// certificatesDataSource is the data source implementation.
+type certificatesDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type certificatesDataSourceModel struct {
+	Id      types.String                 `tfsdk:"id"`
+	Filter  types.String                 `tfsdk:"filter"`
+	Alias   types.String                 `tfsdk:"alias"`
+	SortKey types.String                 `tfsdk:"sort_key"`
+	Order   types.String                 `tfsdk:"order"`
+	Items   []certificateDataSourceModel `tfsdk:"items"`
+}
+
+// Schema defines the schema for the data source.
+func (d *certificatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Lists the Certificates matching the given filters.",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Description: "The matching Certificates.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"alias": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+	config.AddListDataSourceSchema(&schema, "alias")
+	resp.Schema = schema
+}
+
+// Metadata returns the data source type name.
+func (d *certificatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificates"
+}
+
+func (d *certificatesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	d.providerConfig = providerCfg.ProviderConfig
+	d.apiClient = providerCfg.ApiClient
+}
+
+func (d *certificatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state certificatesDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.CheckListOrder(&resp.Diagnostics, state.Order) {
+		return
+	}
+
+	state.Items = []certificateDataSourceModel{}
+	items, httpResp, err := config.ListAllPages(func(page int32) ([]client.TrustedCert, *http.Response, error) {
+		apiListCertificates := d.apiClient.CertificatesApi.GetTrustedCerts(config.AuthContext(ctx, d.providerConfig)).Page(page).NumberPerPage(config.ListPageSize)
+		if internaltypes.IsDefined(state.Filter) {
+			apiListCertificates = apiListCertificates.Filter(state.Filter.ValueString())
+		}
+		if internaltypes.IsDefined(state.Alias) {
+			apiListCertificates = apiListCertificates.Alias(state.Alias.ValueString())
+		}
+		if internaltypes.IsDefined(state.SortKey) {
+			apiListCertificates = apiListCertificates.SortKey(state.SortKey.ValueString())
+		}
+		if internaltypes.IsDefined(state.Order) {
+			apiListCertificates = apiListCertificates.Order(state.Order.ValueString())
+		}
+		apiReadCertificates, httpResp, err := apiListCertificates.Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		return apiReadCertificates.GetItems(), httpResp, nil
+	})
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while listing Certificates", err, httpResp)
+		return
+	}
+	for i := range items {
+		// The alias query parameter isn't an exact match
+		if internaltypes.IsDefined(state.Alias) && items[i].Alias != state.Alias.ValueString() {
+			continue
+		}
+		state.Items = append(state.Items, readCertificateDataSourceResponse(ctx, &items[i], &resp.Diagnostics))
+	}
+
+	state.Id = types.StringValue("certificates")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -2,13 +2,29 @@ package certificates
 
 import (
 	"context"
-
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	client "github.com/pingidentity/pingaccess-go-client"
@@ -18,8 +34,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &certificatesResource{}
-	_ resource.ResourceWithConfigure = &certificatesResource{}
+	_ resource.Resource                = &certificatesResource{}
+	_ resource.ResourceWithConfigure   = &certificatesResource{}
+	_ resource.ResourceWithImportState = &certificatesResource{}
+	_ resource.ResourceWithModifyPlan  = &certificatesResource{}
 )
 
 // CertificateResource is a helper function to simplify the provider implementation.
@@ -34,9 +52,41 @@ type certificatesResource struct {
 }
 
 type certificatesResourceModel struct {
-	Id       types.Int64  `tfsdk:"id"`
-	Alias    types.String `tfsdk:"alias"`
-	FileData types.String `tfsdk:"file_data"`
+	Id                      types.Int64    `tfsdk:"id"`
+	Alias                   types.String   `tfsdk:"alias"`
+	FileData                types.String   `tfsdk:"file_data"`
+	AllowExpired            types.Bool     `tfsdk:"allow_expired"`
+	SubjectDn               types.String   `tfsdk:"subject_dn"`
+	SubjectCn               types.String   `tfsdk:"subject_cn"`
+	IssuerDn                types.String   `tfsdk:"issuer_dn"`
+	SerialNumber            types.String   `tfsdk:"serial_number"`
+	Sha1Fingerprint         types.String   `tfsdk:"sha1_fingerprint"`
+	Sha256Fingerprint       types.String   `tfsdk:"sha256_fingerprint"`
+	ValidFrom               types.String   `tfsdk:"valid_from"`
+	Expires                 types.String   `tfsdk:"expires"`
+	SignatureAlgorithm      types.String   `tfsdk:"signature_algorithm"`
+	KeyAlgorithm            types.String   `tfsdk:"key_algorithm"`
+	KeySize                 types.Int64    `tfsdk:"key_size"`
+	SubjectAlternativeNames types.List     `tfsdk:"subject_alternative_names"`
+	Status                  types.String   `tfsdk:"status"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Computed attributes describing the certificate, which change whenever file_data does
+var certificateMetadataAttributes = []string{
+	"subject_dn",
+	"subject_cn",
+	"issuer_dn",
+	"serial_number",
+	"sha1_fingerprint",
+	"sha256_fingerprint",
+	"valid_from",
+	"expires",
+	"signature_algorithm",
+	"key_algorithm",
+	"key_size",
+	"subject_alternative_names",
+	"status",
 }
 
 // GetSchema defines the schema for the resource.
@@ -61,18 +111,132 @@ func certificatesResourceSchema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"file_data": schema.StringAttribute{
-				Required: true,
+				Description: "Base64-encoded certificate data. The admin API doesn't return it, so after an import it is set to the base64-encoded DER of the certificate exported by PingAccess. Changing it to another encoding of the same certificate only updates the state.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					fileDataValidator{},
+				},
+			},
+			"allow_expired": schema.BoolAttribute{
+				Description: "Allow file_data to hold a certificate that has already expired. Defaults to false.",
+				Optional:    true,
+			},
+			"subject_dn": schema.StringAttribute{
+				Description: "Subject DN of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subject_cn": schema.StringAttribute{
+				Description: "Subject common name of the certificate.",
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"issuer_dn": schema.StringAttribute{
+				Description: "Issuer DN of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"serial_number": schema.StringAttribute{
+				Description: "Serial number of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sha1_fingerprint": schema.StringAttribute{
+				Description: "SHA-1 fingerprint of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sha256_fingerprint": schema.StringAttribute{
+				Description: "Hex-encoded SHA-256 fingerprint of the certificate, computed from file_data.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"valid_from": schema.StringAttribute{
+				Description: "Start of the certificate's validity period, in RFC 3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires": schema.StringAttribute{
+				Description: "End of the certificate's validity period, in RFC 3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"signature_algorithm": schema.StringAttribute{
+				Description: "Algorithm used to sign the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_algorithm": schema.StringAttribute{
+				Description: "Algorithm of the certificate's public key, computed from file_data.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_size": schema.Int64Attribute{
+				Description: "Size in bits of the certificate's public key, computed from file_data.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"subject_alternative_names": schema.ListNestedAttribute{
+				Description: "Subject alternative names of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Type of the subject alternative name, such as DNSName or IPAddress.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "Value of the subject alternative name.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the certificate, such as Valid or Expired.",
+				Computed:    true,
+			},
 		},
 	}
 
 	// Set attribtues in string list
 	if setOptionalToComputed {
-		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"alias", "file_data"})
+		config.SetAllAttributesToOptionalAndComputed(&schema, append([]string{"alias", "file_data", "allow_expired"}, certificateMetadataAttributes...))
 	}
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
 	resp.Schema = schema
 }
 
@@ -97,6 +261,91 @@ func readCertificateResponse(ctx context.Context, r *client.TrustedCert, state *
 	state.Id = internaltypes.Int64InterfaceTypeOrNil(*r.Id)
 	state.Alias = types.StringValue(r.Alias)
 	state.FileData = types.StringValue(X509FileData.ValueString())
+	state.AllowExpired = expectedValues.AllowExpired
+	state.SubjectDn = internaltypes.StringTypeOrNil(r.SubjectDn, false)
+	state.SubjectCn = internaltypes.StringTypeOrNil(r.SubjectCn, false)
+	state.IssuerDn = internaltypes.StringTypeOrNil(r.IssuerDn, false)
+	state.SerialNumber = internaltypes.StringTypeOrNil(r.SerialNumber, false)
+	state.Sha1Fingerprint = internaltypes.StringTypeOrNil(r.Sha1sum, false)
+	state.ValidFrom = internaltypes.TimestampTypeOrNil(r.ValidFrom)
+	state.Expires = internaltypes.TimestampTypeOrNil(r.Expires)
+	state.SignatureAlgorithm = internaltypes.StringTypeOrNil(r.SignatureAlgorithm, false)
+	state.Status = internaltypes.StringTypeOrNil(r.Status, false)
+
+	state.SubjectAlternativeNames = config.GetSubjectAlternativeNames(r.SubjectAlternativeNames, diagnostics)
+
+	// The admin API doesn't report the SHA-256 fingerprint or the public key, so read them from the certificate itself
+	state.Sha256Fingerprint = types.StringNull()
+	state.KeyAlgorithm = types.StringNull()
+	state.KeySize = types.Int64Null()
+	certificate, err := parseSingleCertificate(X509FileData.ValueString())
+	if err != nil {
+		tflog.Debug(ctx, "Unable to parse certificate file_data: "+err.Error())
+		return
+	}
+	readParsedCertificate(certificate, state)
+}
+
+// Read the values known from the certificate itself, which are also used to fill in the plan
+func readParsedCertificate(certificate *x509.Certificate, state *certificatesResourceModel) {
+	fingerprint := sha256.Sum256(certificate.Raw)
+	state.Sha256Fingerprint = types.StringValue(hex.EncodeToString(fingerprint[:]))
+	state.SubjectCn = types.StringValue(certificate.Subject.CommonName)
+	state.ValidFrom = types.StringValue(certificate.NotBefore.UTC().Format(time.RFC3339))
+	state.Expires = types.StringValue(certificate.NotAfter.UTC().Format(time.RFC3339))
+	state.KeyAlgorithm = types.StringValue(certificate.PublicKeyAlgorithm.String())
+	state.KeySize = types.Int64Null()
+	switch publicKey := certificate.PublicKey.(type) {
+	case *rsa.PublicKey:
+		state.KeySize = types.Int64Value(int64(publicKey.N.BitLen()))
+	case *ecdsa.PublicKey:
+		state.KeySize = types.Int64Value(int64(publicKey.Curve.Params().BitSize))
+	case ed25519.PublicKey:
+		state.KeySize = types.Int64Value(int64(len(publicKey) * 8))
+	}
+}
+
+func (r *certificatesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when destroying the resource
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan certificatesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state certificatesResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		// The same certificate in a different encoding leaves the metadata unchanged
+		if resp.Diagnostics.HasError() || sameCertificate(plan.FileData, state.FileData) {
+			return
+		}
+		// Replacing the certificate data changes all of the certificate's metadata
+		plan.SubjectDn = types.StringUnknown()
+		plan.SubjectCn = types.StringUnknown()
+		plan.IssuerDn = types.StringUnknown()
+		plan.SerialNumber = types.StringUnknown()
+		plan.Sha1Fingerprint = types.StringUnknown()
+		plan.Sha256Fingerprint = types.StringUnknown()
+		plan.ValidFrom = types.StringUnknown()
+		plan.Expires = types.StringUnknown()
+		plan.SignatureAlgorithm = types.StringUnknown()
+		plan.KeyAlgorithm = types.StringUnknown()
+		plan.KeySize = types.Int64Unknown()
+		plan.SubjectAlternativeNames = types.ListUnknown(types.ObjectType{AttrTypes: config.SubjectAlternativeNameAttrTypes})
+		plan.Status = types.StringUnknown()
+	}
+
+	// Show what can be read from the new certificate in the plan. Invalid data is reported by the file_data validator.
+	if internaltypes.IsDefined(plan.FileData) {
+		certificate, err := parseSingleCertificate(plan.FileData.ValueString())
+		if err == nil {
+			readParsedCertificate(certificate, &plan)
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 }
 
 func (r *certificatesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -107,18 +356,26 @@ func (r *certificatesResource) Create(ctx context.Context, req resource.CreateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 	createCertificate := client.NewX509FileImportDoc(plan.Alias.ValueString(), plan.FileData.ValueString())
 	requestJson, err := createCertificate.MarshalJSON()
 	if err == nil {
 		tflog.Debug(ctx, "Add request: "+string(requestJson))
 	}
-	apiCreateCertificate := r.apiClient.CertificatesApi.ImportTrustedCert(config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiCreateCertificate := r.apiClient.CertificatesApi.ImportTrustedCert(config.AuthContext(ctx, r.providerConfig))
 	apiCreateCertificate = apiCreateCertificate.X509File(*createCertificate)
 	certificateResponse, httpResp, err := r.apiClient.CertificatesApi.ImportTrustedCertExecute(apiCreateCertificate)
-	if httpResp.StatusCode != 200 {
+	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating a Certificate", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
 	responseJson, err := certificateResponse.MarshalJSON()
 	if err == nil {
 		tflog.Debug(ctx, "Add response: "+string(responseJson))
@@ -128,6 +385,7 @@ func (r *certificatesResource) Create(ctx context.Context, req resource.CreateRe
 	var state certificatesResourceModel
 
 	readCertificateResponse(ctx, certificateResponse, &state, &plan, &resp.Diagnostics, plan.FileData)
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -147,9 +405,18 @@ func readCertificate(ctx context.Context, req resource.ReadRequest, resp *resour
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	apiReadCertificate, httpResp, err := apiClient.CertificatesApi.GetTrustedCert(config.ProviderBasicAuthContext(ctx, providerConfig), internaltypes.Int64ToString(state.Id)).Execute()
-
-	if httpResp.StatusCode != 200 {
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	apiReadCertificate, httpResp, err := apiClient.CertificatesApi.GetTrustedCert(config.AuthContext(ctx, providerConfig), internaltypes.Int64ToString(state.Id)).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "Certificate", httpResp) {
+		return
+	}
+	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Certificate", err, httpResp)
 		return
 	}
@@ -159,8 +426,17 @@ func readCertificate(ctx context.Context, req resource.ReadRequest, resp *resour
 		tflog.Debug(ctx, "Read response: "+string(responseJson))
 	}
 
+	// file_data isn't returned by the admin API, so rebuild it from the exported certificate after an import
+	fileData := state.FileData
+	if fileData.IsNull() {
+		fileData = exportCertificateFileData(ctx, &resp.Diagnostics, apiClient, providerConfig, state.Id)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Read the response into the state
-	readCertificateResponse(ctx, apiReadCertificate, &state, &state, &resp.Diagnostics, state.FileData)
+	readCertificateResponse(ctx, apiReadCertificate, &state, &state, &resp.Diagnostics, fileData)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -171,6 +447,21 @@ func readCertificate(ctx context.Context, req resource.ReadRequest, resp *resour
 
 }
 
+// Export a certificate and encode it the way file_data expects, as base64-encoded DER
+func exportCertificateFileData(ctx context.Context, diagnostics *diag.Diagnostics, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, id types.Int64) types.String {
+	exportedCertificate, httpResp, err := apiClient.CertificatesApi.ExportTrustedCert(config.AuthContext(ctx, providerConfig), internaltypes.Int64ToString(id)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diagnostics, "An error occurred while exporting a Certificate", err, httpResp)
+		return types.StringNull()
+	}
+	block, _ := pem.Decode([]byte(exportedCertificate))
+	if block == nil {
+		diagnostics.AddError("Unable to read exported Certificate", "The certificate exported by PingAccess is not PEM-encoded")
+		return types.StringNull()
+	}
+	return types.StringValue(base64.StdEncoding.EncodeToString(block.Bytes))
+}
+
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *certificatesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	updateCertificate(ctx, req, resp, r.apiClient, r.providerConfig)
@@ -184,11 +475,26 @@ func updateCertificate(ctx context.Context, req resource.UpdateRequest, resp *re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Get the current state to see how any attributes are changing
 	var state certificatesResourceModel
 	req.State.Get(ctx, &state)
-	updateCertificate := apiClient.CertificatesApi.UpdateTrustedCert(config.ProviderBasicAuthContext(ctx, providerConfig), internaltypes.Int64ToString(state.Id))
+	// Changing only the encoding of file_data, such as from the DER set by an import to PEM, doesn't need an API call
+	if plan.Alias.Equal(state.Alias) && sameCertificate(plan.FileData, state.FileData) {
+		state.FileData = plan.FileData
+		state.AllowExpired = plan.AllowExpired
+		state.Timeouts = plan.Timeouts
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return
+	}
+	updateCertificate := apiClient.CertificatesApi.UpdateTrustedCert(config.AuthContext(ctx, providerConfig), internaltypes.Int64ToString(state.Id))
 	CreateUpdateRequest := client.NewX509FileImportDoc(plan.Alias.ValueString(), plan.FileData.ValueString())
 	requestJson, err := CreateUpdateRequest.MarshalJSON()
 	if err == nil {
@@ -196,10 +502,11 @@ func updateCertificate(ctx context.Context, req resource.UpdateRequest, resp *re
 	}
 	updateCertificate = updateCertificate.X509File(*CreateUpdateRequest)
 	updateCertificateResponse, httpResp, err := apiClient.CertificatesApi.UpdateTrustedCertExecute(updateCertificate)
-	if httpResp.StatusCode != 200 {
+	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating a certificate", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 	// Log response JSON
 	responseJson, err := updateCertificateResponse.MarshalJSON()
 	if err == nil {
@@ -209,6 +516,7 @@ func updateCertificate(ctx context.Context, req resource.UpdateRequest, resp *re
 	readCertificateResponse(ctx, updateCertificateResponse, &state, &plan, &resp.Diagnostics, plan.FileData)
 
 	// Update computed values
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -228,11 +536,58 @@ func deleteCertificate(ctx context.Context, req resource.DeleteRequest, resp *re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
-	httpResp, err := apiClient.CertificatesApi.DeleteTrustedCert(config.ProviderBasicAuthContext(ctx, providerConfig), internaltypes.Int64ToString(state.Id)).Execute()
-	if httpResp.StatusCode != 200 {
+	httpResp, err := apiClient.CertificatesApi.DeleteTrustedCert(config.AuthContext(ctx, providerConfig), internaltypes.Int64ToString(state.Id)).Execute()
+	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting a Certificate", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
+
+}
 
+func (r *certificatesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Import by alias when the import ID is "alias:<alias>", otherwise by numeric ID
+	if strings.HasPrefix(req.ID, config.ImportByAliasPrefix) {
+		alias := strings.TrimPrefix(req.ID, config.ImportByAliasPrefix)
+		var ids []int64
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.TrustedCert, *http.Response, error) {
+			apiReadCertificates, httpResp, err := apiClient.CertificatesApi.GetTrustedCerts(config.AuthContext(ctx, providerConfig)).Alias(alias).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadCertificates.GetItems(), httpResp, nil
+		})
+		for i := range items {
+			if items[i].Alias == alias {
+				ids = append(ids, *items[i].Id)
+			}
+		}
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Certificate to import", err, httpResp)
+			return
+		}
+		if !config.CheckLookupMatches(&resp.Diagnostics, "Certificate", "alias \""+alias+"\"", len(ids)) {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ids[0])...)
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected a numeric Certificate ID or alias:<alias>, got \""+req.ID+"\"")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
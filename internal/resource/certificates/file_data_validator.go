@@ -0,0 +1,119 @@
+package certificates
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ validator.String = fileDataValidator{}
+
+// Validator that checks file_data holds exactly one certificate, and no private key, before it is sent to PingAccess.
+// Expired certificates are rejected unless allow_expired is set.
+type fileDataValidator struct{}
+
+func (v fileDataValidator) Description(_ context.Context) string {
+	return "value must be a base64-encoded DER or PEM certificate"
+}
+
+func (v fileDataValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v fileDataValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	certificate, err := parseSingleCertificate(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid certificate file_data", err.Error())
+		return
+	}
+
+	if time.Now().After(certificate.NotAfter) {
+		var allowExpired types.Bool
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("allow_expired"), &allowExpired)...)
+		if allowExpired.IsUnknown() || allowExpired.ValueBool() {
+			return
+		}
+		resp.Diagnostics.AddAttributeError(req.Path, "Expired certificate",
+			fmt.Sprintf("The certificate for %s expired at %s. Set allow_expired to true to import it anyway.",
+				certificate.Subject.String(), certificate.NotAfter.UTC().Format(time.RFC3339)))
+	}
+}
+
+var errPrivateKey = errors.New("file_data must not contain a private key. Use a key pair to import a certificate with its private key")
+
+// Check whether two file_data values hold the same certificate, even if one is PEM and the other DER. After an
+// import file_data is the DER exported by PingAccess, while the configuration may hold PEM.
+func sameCertificate(a, b types.String) bool {
+	if !internaltypes.IsDefined(a) || !internaltypes.IsDefined(b) {
+		return false
+	}
+	if a.Equal(b) {
+		return true
+	}
+	certificateA, err := parseSingleCertificate(a.ValueString())
+	if err != nil {
+		return false
+	}
+	certificateB, err := parseSingleCertificate(b.ValueString())
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(certificateA.Raw, certificateB.Raw)
+}
+
+// Decode base64-encoded certificate data, requiring it to hold a single DER or PEM encoded certificate
+func parseSingleCertificate(fileData string) (*x509.Certificate, error) {
+	data, err := base64.StdEncoding.DecodeString(fileData)
+	if err != nil {
+		return nil, fmt.Errorf("file_data must be base64-encoded: %w", err)
+	}
+
+	// PEM input may hold several blocks, which must be a single certificate
+	if block, rest := pem.Decode(data); block != nil {
+		var certificateBytes []byte
+		for block != nil {
+			switch {
+			case strings.Contains(block.Type, "PRIVATE KEY"):
+				return nil, errPrivateKey
+			case block.Type != "CERTIFICATE":
+				return nil, fmt.Errorf("file_data contains an unexpected %s PEM block", block.Type)
+			case certificateBytes != nil:
+				return nil, errors.New("file_data must contain a single certificate, but contains more than one")
+			}
+			certificateBytes = block.Bytes
+			block, rest = pem.Decode(rest)
+		}
+		data = certificateBytes
+	}
+
+	certificates, err := x509.ParseCertificates(data)
+	if err != nil {
+		if _, keyErr := x509.ParsePKCS8PrivateKey(data); keyErr == nil {
+			return nil, errPrivateKey
+		}
+		if _, keyErr := x509.ParsePKCS1PrivateKey(data); keyErr == nil {
+			return nil, errPrivateKey
+		}
+		return nil, fmt.Errorf("file_data is not a valid certificate: %w", err)
+	}
+	if len(certificates) != 1 {
+		return nil, fmt.Errorf("file_data must contain a single certificate, but contains %d", len(certificates))
+	}
+	return certificates[0], nil
+}
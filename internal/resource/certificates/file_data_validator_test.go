@@ -0,0 +1,202 @@
+package certificates
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Generate a self-signed certificate, returning its DER encoding and the DER encoding of its PKCS#8 private key
+func testCertificate(t *testing.T, commonName string, notAfter time.Time) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notAfter.Add(-48 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return der, keyDer
+}
+
+// Base64 encode PEM blocks of the given type, as file_data holds them
+func testPemFileData(blockType string, ders ...[]byte) string {
+	var data []byte
+	for _, der := range ders {
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})...)
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func testDerFileData(der []byte) string {
+	return base64.StdEncoding.EncodeToString(der)
+}
+
+func TestParseSingleCertificate(t *testing.T) {
+	certificateDer, keyDer := testCertificate(t, "example", time.Now().Add(time.Hour))
+	otherCertificateDer, _ := testCertificate(t, "other", time.Now().Add(time.Hour))
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs1KeyDer := x509.MarshalPKCS1PrivateKey(rsaKey)
+	certificateAndKey := base64.StdEncoding.EncodeToString(append(
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificateDer}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer})...))
+
+	tests := []struct {
+		name          string
+		fileData      string
+		expectedError string
+	}{
+		{"PEM certificate", testPemFileData("CERTIFICATE", certificateDer), ""},
+		{"DER certificate", testDerFileData(certificateDer), ""},
+		{"not base64", "not base64!", "must be base64-encoded"},
+		{"not a certificate", testDerFileData([]byte("not a certificate")), "is not a valid certificate"},
+		{"PEM private key", testPemFileData("PRIVATE KEY", keyDer), errPrivateKey.Error()},
+		{"PEM RSA private key", testPemFileData("RSA PRIVATE KEY", pkcs1KeyDer), errPrivateKey.Error()},
+		{"PEM certificate and private key", certificateAndKey, errPrivateKey.Error()},
+		{"DER PKCS#8 private key", testDerFileData(keyDer), errPrivateKey.Error()},
+		{"DER PKCS#1 private key", testDerFileData(pkcs1KeyDer), errPrivateKey.Error()},
+		{"PEM certificate request", testPemFileData("CERTIFICATE REQUEST", certificateDer), "unexpected CERTIFICATE REQUEST PEM block"},
+		{"multiple PEM certificates", testPemFileData("CERTIFICATE", certificateDer, otherCertificateDer), "contains more than one"},
+		{"multiple DER certificates", testDerFileData(append(append([]byte{}, certificateDer...), otherCertificateDer...)), "contains 2"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			certificate, err := parseSingleCertificate(test.fileData)
+			if test.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), test.expectedError) {
+					t.Fatalf("expected an error containing %q, got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if certificate.Subject.CommonName != "example" {
+				t.Errorf("expected the example certificate, got %s", certificate.Subject.CommonName)
+			}
+		})
+	}
+}
+
+func TestSameCertificate(t *testing.T) {
+	certificateDer, _ := testCertificate(t, "example", time.Now().Add(time.Hour))
+	otherCertificateDer, _ := testCertificate(t, "other", time.Now().Add(time.Hour))
+	pemFileData := types.StringValue(testPemFileData("CERTIFICATE", certificateDer))
+	derFileData := types.StringValue(testDerFileData(certificateDer))
+
+	tests := []struct {
+		name string
+		a    types.String
+		b    types.String
+		same bool
+	}{
+		{"identical values", pemFileData, pemFileData, true},
+		{"PEM and DER of one certificate", pemFileData, derFileData, true},
+		{"DER and PEM of one certificate", derFileData, pemFileData, true},
+		{"different certificates", pemFileData, types.StringValue(testDerFileData(otherCertificateDer)), false},
+		{"invalid value", pemFileData, types.StringValue("not base64!"), false},
+		{"null value", types.StringNull(), derFileData, false},
+		{"unknown value", derFileData, types.StringUnknown(), false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if same := sameCertificate(test.a, test.b); same != test.same {
+				t.Errorf("expected sameCertificate to be %t", test.same)
+			}
+		})
+	}
+}
+
+// Build a configuration holding file_data and allow_expired, as the certificate resource schema does
+func testFileDataConfig(fileData string, allowExpired *bool) tfsdk.Config {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"file_data":     tftypes.String,
+		"allow_expired": tftypes.Bool,
+	}}
+	var allowExpiredValue interface{}
+	if allowExpired != nil {
+		allowExpiredValue = *allowExpired
+	}
+	return tfsdk.Config{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"file_data":     schema.StringAttribute{Required: true},
+				"allow_expired": schema.BoolAttribute{Optional: true},
+			},
+		},
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"file_data":     tftypes.NewValue(tftypes.String, fileData),
+			"allow_expired": tftypes.NewValue(tftypes.Bool, allowExpiredValue),
+		}),
+	}
+}
+
+func TestFileDataValidator(t *testing.T) {
+	validDer, keyDer := testCertificate(t, "valid", time.Now().Add(time.Hour))
+	expiredDer, _ := testCertificate(t, "expired", time.Now().Add(-time.Hour))
+	allowExpired := true
+	disallowExpired := false
+
+	tests := []struct {
+		name          string
+		fileData      string
+		allowExpired  *bool
+		expectedError string
+	}{
+		{"valid certificate", testPemFileData("CERTIFICATE", validDer), nil, ""},
+		{"expired certificate", testPemFileData("CERTIFICATE", expiredDer), nil, "Expired certificate"},
+		{"expired certificate not allowed", testDerFileData(expiredDer), &disallowExpired, "Expired certificate"},
+		{"expired certificate allowed", testDerFileData(expiredDer), &allowExpired, ""},
+		{"private key", testPemFileData("PRIVATE KEY", keyDer), nil, "Invalid certificate file_data"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("file_data"),
+				ConfigValue: types.StringValue(test.fileData),
+				Config:      testFileDataConfig(test.fileData, test.allowExpired),
+			}
+			var resp validator.StringResponse
+			fileDataValidator{}.ValidateString(context.Background(), req, &resp)
+			if test.expectedError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != test.expectedError {
+				t.Fatalf("expected a %q error, got %v", test.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
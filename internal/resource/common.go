@@ -1,6 +1,11 @@
 package config
 
 import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
@@ -11,6 +16,44 @@ import (
 	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
 )
 
+// Default time allowed for a resource operation when none is set in the timeouts block
+const DefaultOperationTimeout = 20 * time.Minute
+
+// Add the timeouts block, allowing the operations in opts to set their own deadlines
+func AddTimeoutsBlock(ctx context.Context, s *schema.Schema, opts timeouts.Opts) {
+	if s.Blocks == nil {
+		s.Blocks = map[string]schema.Block{}
+	}
+	s.Blocks["timeouts"] = timeouts.Block(ctx, opts)
+}
+
+// Add the id placeholder and the filtering and sorting attributes shared by list data sources. If
+// nameAttribute is set, an exact name filter is added under that attribute name.
+func AddListDataSourceSchema(s *datasourceschema.Schema, nameAttribute string) {
+	s.Attributes["id"] = datasourceschema.StringAttribute{
+		Description: "Placeholder name of this object required by Terraform.",
+		Computed:    true,
+	}
+	s.Attributes["filter"] = datasourceschema.StringAttribute{
+		Description: "Return only items matching this value. The attributes searched depend on the object type.",
+		Optional:    true,
+	}
+	s.Attributes["sort_key"] = datasourceschema.StringAttribute{
+		Description: "The attribute to sort the returned items by.",
+		Optional:    true,
+	}
+	s.Attributes["order"] = datasourceschema.StringAttribute{
+		Description: "The order to sort the returned items in, either ASC or DESC.",
+		Optional:    true,
+	}
+	if nameAttribute != "" {
+		s.Attributes[nameAttribute] = datasourceschema.StringAttribute{
+			Description: "Return only items with exactly this " + nameAttribute + ".",
+			Optional:    true,
+		}
+	}
+}
+
 // Get schema elements common to all resources
 func AddCommonSchema(s *schema.Schema, idRequired bool) {
 	// If ID is required (for instantiable config objects) then set it as Required and
@@ -0,0 +1,148 @@
+package engineListener
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &engineListenerDataSource{}
+	_ datasource.DataSourceWithConfigure = &engineListenerDataSource{}
+)
+
+// EngineListenerDataSource is a helper function to simplify the provider implementation.
+func EngineListenerDataSource() datasource.DataSource {
+	return &engineListenerDataSource{}
+}
+
+// engineListenerDataSource is the data source implementation.
+type engineListenerDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type engineListenerDataSourceModel struct {
+	Id                        types.String `tfsdk:"id"`
+	Name                      types.String `tfsdk:"name"`
+	Port                      types.Int64  `tfsdk:"port"`
+	Secure                    types.Bool   `tfsdk:"secure"`
+	TrustedCertificateGroupId types.Int64  `tfsdk:"trusted_certificate_group_id"`
+}
+
+// Schema defines the schema for the data source.
+func (d *engineListenerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an Engine Listener by id or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the Engine Listener. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Exact name of the Engine Listener. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"port": schema.Int64Attribute{
+				Computed: true,
+			},
+			"secure": schema.BoolAttribute{
+				Computed: true,
+			},
+			"trusted_certificate_group_id": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *engineListenerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_engine_listener"
+}
+
+func (d *engineListenerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	d.providerConfig = providerCfg.ProviderConfig
+	d.apiClient = providerCfg.ApiClient
+}
+
+func (d *engineListenerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state engineListenerDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.CheckDataSourceLookup(&resp.Diagnostics, state.Id, state.Name, "name") {
+		return
+	}
+
+	var engineListener *client.EngineListener
+	if internaltypes.IsDefined(state.Id) {
+		apiReadEngineListener, httpResp, err := d.apiClient.EngineListenersApi.GetEngineListener(config.AuthContext(ctx, d.providerConfig), state.Id.ValueString()).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an Engine Listener", err, httpResp)
+			return
+		}
+		engineListener = apiReadEngineListener
+	} else {
+		name := state.Name.ValueString()
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.EngineListener, *http.Response, error) {
+			apiReadEngineListeners, httpResp, err := d.apiClient.EngineListenersApi.GetEngineListeners(config.AuthContext(ctx, d.providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadEngineListeners.GetItems(), httpResp, nil
+		})
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an Engine Listener", err, httpResp)
+			return
+		}
+		// Only exact matches count
+		var matches []client.EngineListener
+		for _, item := range items {
+			if item.Name == name {
+				matches = append(matches, item)
+			}
+		}
+		if !config.CheckLookupMatches(&resp.Diagnostics, "Engine Listener", "name \""+name+"\"", len(matches)) {
+			return
+		}
+		engineListener = &matches[0]
+	}
+	// Log response JSON
+	responseJson, err := engineListener.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response using the resource mapper
+	var model engineListenerResourceModel
+	readEngineListenerResponse(ctx, engineListener, &model, &model)
+	state = engineListenerDataSourceModel{
+		Id:                        model.Id,
+		Name:                      model.Name,
+		Port:                      model.Port,
+		Secure:                    model.Secure,
+		TrustedCertificateGroupId: model.TrustedCertificateGroupId,
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
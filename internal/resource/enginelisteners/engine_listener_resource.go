@@ -2,8 +2,9 @@ package engineListener
 
 import (
 	"context"
+	"net/http"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -36,11 +37,12 @@ type engineListenerResource struct {
 }
 
 type engineListenerResourceModel struct {
-	Id                        types.String `tfsdk:"id"`
-	Name                      types.String `tfsdk:"name"`
-	Port                      types.Int64  `tfsdk:"port"`
-	Secure                    types.Bool   `tfsdk:"secure"`
-	TrustedCertificateGroupId types.Int64  `tfsdk:"trusted_certificate_group_id"`
+	Id                        types.String   `tfsdk:"id"`
+	Name                      types.String   `tfsdk:"name"`
+	Port                      types.Int64    `tfsdk:"port"`
+	Secure                    types.Bool     `tfsdk:"secure"`
+	TrustedCertificateGroupId types.Int64    `tfsdk:"trusted_certificate_group_id"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -86,6 +88,12 @@ func enginelistenerResourceSchema(ctx context.Context, req resource.SchemaReques
 	if setOptionalToComputed {
 		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"name", "port"})
 	}
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
 	resp.Schema = schema
 }
 func addOptionalEngineListenerFields(ctx context.Context, addRequest *client.EngineListener, plan engineListenerResourceModel) error {
@@ -136,6 +144,13 @@ func (r *engineListenerResource) Create(ctx context.Context, req resource.Create
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	createListener := client.NewEngineListener(plan.Name.ValueString(), plan.Port.ValueInt64())
 	err := addOptionalEngineListenerFields(ctx, createListener, plan)
@@ -148,7 +163,7 @@ func (r *engineListenerResource) Create(ctx context.Context, req resource.Create
 		tflog.Debug(ctx, "Add request: "+string(requestJson))
 	}
 
-	apiCreateListener := r.apiClient.EngineListenersApi.AddEngineListener(config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiCreateListener := r.apiClient.EngineListenersApi.AddEngineListener(config.AuthContext(ctx, r.providerConfig))
 	apiCreateListener = apiCreateListener.EngineListener(*createListener)
 	listenerResponse, httpResp, err := r.apiClient.EngineListenersApi.AddEngineListenerExecute(apiCreateListener)
 
@@ -156,6 +171,7 @@ func (r *engineListenerResource) Create(ctx context.Context, req resource.Create
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the engine listener", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
 	responseJson, err := listenerResponse.MarshalJSON()
 	if err == nil {
 		tflog.Debug(ctx, "Add response: "+string(responseJson))
@@ -165,6 +181,7 @@ func (r *engineListenerResource) Create(ctx context.Context, req resource.Create
 	var state engineListenerResourceModel
 
 	readEngineListenerResponse(ctx, listenerResponse, &state, &plan)
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -184,8 +201,17 @@ func readEngineListener(ctx context.Context, req resource.ReadRequest, resp *res
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	apiReadListener, httpResp, err := apiClient.EngineListenersApi.GetEngineListener(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
-
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	apiReadListener, httpResp, err := apiClient.EngineListenersApi.GetEngineListener(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "Engine Listener", httpResp) {
+		return
+	}
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an engine listener", err, httpResp)
 		return
@@ -221,11 +247,18 @@ func updateEngineListener(ctx context.Context, req resource.UpdateRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Get the current state to see how any attributes are changing
 	var state engineListenerResourceModel
 	req.State.Get(ctx, &state)
-	UpdateListener := apiClient.EngineListenersApi.UpdateEngineListener(config.ProviderBasicAuthContext(ctx, providerConfig), (plan.Id.ValueString()))
+	UpdateListener := apiClient.EngineListenersApi.UpdateEngineListener(config.AuthContext(ctx, providerConfig), (plan.Id.ValueString()))
 	CreateUpdateRequest := client.NewEngineListener(plan.Name.ValueString(), plan.Port.ValueInt64())
 	err := addOptionalEngineListenerFields(ctx, CreateUpdateRequest, plan)
 	if err != nil {
@@ -242,6 +275,7 @@ func updateEngineListener(ctx context.Context, req resource.UpdateRequest, resp
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating engine listener", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 	// Log response JSON
 	responseJson, err := updateListenerResponse.MarshalJSON()
 	if err == nil {
@@ -251,6 +285,7 @@ func updateEngineListener(ctx context.Context, req resource.UpdateRequest, resp
 	readEngineListenerResponse(ctx, updateListenerResponse, &state, &plan)
 
 	// Update computed values
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -271,18 +306,41 @@ func deleteEngineListener(ctx context.Context, req resource.DeleteRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	httpResp, err := apiClient.EngineListenersApi.DeleteEngineListener(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	httpResp, err := apiClient.EngineListenersApi.DeleteEngineListener(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting an engine listener", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 
 }
 
 func (r *engineListenerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	importLocation(ctx, req, resp)
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
 }
-func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve import ID and save to id attribute, looking the ID up first when importing by name
+	config.ImportByIdOrName(ctx, req, resp, "Engine Listener", func(name string) ([]string, *http.Response, error) {
+		var ids []string
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.EngineListener, *http.Response, error) {
+			apiReadEngineListeners, httpResp, err := apiClient.EngineListenersApi.GetEngineListeners(config.AuthContext(ctx, providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadEngineListeners.GetItems(), httpResp, nil
+		})
+		for i := range items {
+			if items[i].Name == name {
+				ids = append(ids, internaltypes.Int64PointerToString(*items[i].Id))
+			}
+		}
+		return ids, httpResp, err
+	})
 }
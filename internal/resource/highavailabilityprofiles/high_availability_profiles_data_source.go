@@ -0,0 +1,167 @@
+package highAvailabilityProfiles
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &availabilityProfileDataSource{}
+	_ datasource.DataSourceWithConfigure = &availabilityProfileDataSource{}
+)
+
+// AvailabilityProfileDataSource is a helper function to simplify the provider implementation.
+func AvailabilityProfileDataSource() datasource.DataSource {
+	return &availabilityProfileDataSource{}
+}
+
+// availabilityProfileDataSource is the data source implementation.
+type availabilityProfileDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type availabilityProfileDataSourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	ClassName     types.String `tfsdk:"classname"`
+	Configuration types.Object `tfsdk:"configuration"`
+}
+
+// Schema defines the schema for the data source.
+func (d *availabilityProfileDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an Availability Profile by id or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the Availability Profile. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Exact name of the Availability Profile. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"classname": schema.StringAttribute{
+				Computed: true,
+			},
+			"configuration": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"connect_timeout": schema.Float64Attribute{
+						Computed: true,
+					},
+					"pooled_connection_timeout": schema.Float64Attribute{
+						Computed: true,
+					},
+					"read_timeout": schema.Float64Attribute{
+						Computed: true,
+					},
+					"max_retries": schema.Float64Attribute{
+						Computed: true,
+					},
+					"retry_delay": schema.Float64Attribute{
+						Computed: true,
+					},
+					"failed_retry_timeout": schema.Float64Attribute{
+						Computed: true,
+					},
+					"failure_http_status_codes": schema.SetAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *availabilityProfileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_high_availability_profile"
+}
+
+func (d *availabilityProfileDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	d.providerConfig = providerCfg.ProviderConfig
+	d.apiClient = providerCfg.ApiClient
+}
+
+func (d *availabilityProfileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state availabilityProfileDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.CheckDataSourceLookup(&resp.Diagnostics, state.Id, state.Name, "name") {
+		return
+	}
+
+	var availabilityProfile *client.AvailabilityProfile
+	if internaltypes.IsDefined(state.Id) {
+		apiReadAvailabilityProfile, httpResp, err := d.apiClient.HighAvailabilityApi.GetAvailabilityProfile(config.AuthContext(ctx, d.providerConfig), state.Id.ValueString()).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an Availability Profile", err, httpResp)
+			return
+		}
+		availabilityProfile = apiReadAvailabilityProfile
+	} else {
+		name := state.Name.ValueString()
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.AvailabilityProfile, *http.Response, error) {
+			apiReadAvailabilityProfiles, httpResp, err := d.apiClient.HighAvailabilityApi.GetAvailabilityProfiles(config.AuthContext(ctx, d.providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadAvailabilityProfiles.GetItems(), httpResp, nil
+		})
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an Availability Profile", err, httpResp)
+			return
+		}
+		// Only exact matches count
+		var matches []client.AvailabilityProfile
+		for _, item := range items {
+			if item.Name == name {
+				matches = append(matches, item)
+			}
+		}
+		if !config.CheckLookupMatches(&resp.Diagnostics, "Availability Profile", "name \""+name+"\"", len(matches)) {
+			return
+		}
+		availabilityProfile = &matches[0]
+	}
+	// Log response JSON
+	responseJson, err := availabilityProfile.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response using the resource mapper
+	var model availabilityProfileResourceModel
+	readAvailabilityProfileResponse(ctx, availabilityProfile, &model, &model, &resp.Diagnostics)
+	state = availabilityProfileDataSourceModel{
+		Id:            model.Id,
+		Name:          model.Name,
+		ClassName:     model.ClassName,
+		Configuration: model.Configuration,
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -2,10 +2,11 @@ package highAvailabilityProfiles
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
@@ -40,10 +41,11 @@ type availabilityProfileResource struct {
 }
 
 type availabilityProfileResourceModel struct {
-	ClassName     types.String `tfsdk:"classname"`
-	Id            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	Configuration types.Object `tfsdk:"configuration"`
+	ClassName     types.String   `tfsdk:"classname"`
+	Id            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	Configuration types.Object   `tfsdk:"configuration"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -132,6 +134,12 @@ func availabilityProfileResourceSchema(ctx context.Context, req resource.SchemaR
 	if setOptionalToComputed {
 		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"classname", "name", "configuration"})
 	}
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
 	resp.Schema = schema
 }
 
@@ -196,6 +204,13 @@ func (r *availabilityProfileResource) Create(ctx context.Context, req resource.C
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 	createAvailabilityProfile := client.NewAvailabilityProfile(plan.ClassName.ValueString(), plan.Name.ValueString())
 	err := addOptionalAvailabilityProfileFields(ctx, createAvailabilityProfile, plan)
 	if err != nil {
@@ -206,13 +221,14 @@ func (r *availabilityProfileResource) Create(ctx context.Context, req resource.C
 	if err == nil {
 		tflog.Debug(ctx, "Add request: "+string(requestJson))
 	}
-	apiCreateAvailabilityProfile := r.apiClient.HighAvailabilityApi.AddAvailabilityProfile(config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiCreateAvailabilityProfile := r.apiClient.HighAvailabilityApi.AddAvailabilityProfile(config.AuthContext(ctx, r.providerConfig))
 	apiCreateAvailabilityProfile = apiCreateAvailabilityProfile.AvailabilityProfile(*createAvailabilityProfile)
 	highAvailabilityProfileResponse, httpResp, err := r.apiClient.HighAvailabilityApi.AddAvailabilityProfileExecute(apiCreateAvailabilityProfile)
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating High Availability Profile", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
 	responseJson, err := highAvailabilityProfileResponse.MarshalJSON()
 	if err == nil {
 		tflog.Debug(ctx, "Add response: "+string(responseJson))
@@ -222,6 +238,7 @@ func (r *availabilityProfileResource) Create(ctx context.Context, req resource.C
 	var state availabilityProfileResourceModel
 
 	readAvailabilityProfileResponse(ctx, highAvailabilityProfileResponse, &state, &plan, &resp.Diagnostics)
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -241,8 +258,18 @@ func readAvailabilityProfile(ctx context.Context, req resource.ReadRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
-	apiReadAvailabilityProfile, httpResp, err := apiClient.HighAvailabilityApi.GetAvailabilityProfile(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	apiReadAvailabilityProfile, httpResp, err := apiClient.HighAvailabilityApi.GetAvailabilityProfile(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "High Availability Profile", httpResp) {
+		return
+	}
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an High Availability Profile", err, httpResp)
 		return
@@ -278,11 +305,18 @@ func updateAvailabilityProfile(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Get the current state to see how any attributes are changing
 	var state availabilityProfileResourceModel
 	req.State.Get(ctx, &state)
-	UpdateAvailabilityProfile := apiClient.HighAvailabilityApi.UpdateAvailabilityProfile(config.ProviderBasicAuthContext(ctx, providerConfig), plan.Id.ValueString())
+	UpdateAvailabilityProfile := apiClient.HighAvailabilityApi.UpdateAvailabilityProfile(config.AuthContext(ctx, providerConfig), plan.Id.ValueString())
 	CreateUpdateRequest := client.NewAvailabilityProfile(plan.ClassName.ValueString(), plan.Name.ValueString())
 	err := addOptionalAvailabilityProfileFields(ctx, CreateUpdateRequest, plan)
 	if err != nil {
@@ -299,6 +333,7 @@ func updateAvailabilityProfile(ctx context.Context, req resource.UpdateRequest,
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating High Availability Profile", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 	// Log response JSON
 	responseJson, err := UpdateAvailabilityProfileResponse.MarshalJSON()
 	if err == nil {
@@ -308,6 +343,7 @@ func updateAvailabilityProfile(ctx context.Context, req resource.UpdateRequest,
 	readAvailabilityProfileResponse(ctx, UpdateAvailabilityProfileResponse, &state, &plan, &resp.Diagnostics)
 
 	// Update computed values
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -328,16 +364,40 @@ func deleteAvailabilityProfile(ctx context.Context, req resource.DeleteRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	httpResp, err := apiClient.HighAvailabilityApi.DeleteAvailabilityProfile(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	httpResp, err := apiClient.HighAvailabilityApi.DeleteAvailabilityProfile(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting High Availability Profile", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 }
 
 func (r *availabilityProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	importLocation(ctx, req, resp)
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
 }
-func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve import ID and save to id attribute, looking the ID up first when importing by name
+	config.ImportByIdOrName(ctx, req, resp, "High Availability Profile", func(name string) ([]string, *http.Response, error) {
+		var ids []string
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.AvailabilityProfile, *http.Response, error) {
+			apiReadAvailabilityProfiles, httpResp, err := apiClient.HighAvailabilityApi.GetAvailabilityProfiles(config.AuthContext(ctx, providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadAvailabilityProfiles.GetItems(), httpResp, nil
+		})
+		for i := range items {
+			if items[i].Name == name {
+				ids = append(ids, internaltypes.Int64PointerToString(*items[i].Id))
+			}
+		}
+		return ids, httpResp, err
+	})
 }
@@ -0,0 +1,168 @@
+package hsmprovider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &hsmProviderDataSource{}
+	_ datasource.DataSourceWithConfigure = &hsmProviderDataSource{}
+)
+
+// HsmProviderDataSource is a helper function to simplify the provider implementation.
+func HsmProviderDataSource() datasource.DataSource {
+	return &hsmProviderDataSource{}
+}
+
+// hsmProviderDataSource is the data source implementation.
+type hsmProviderDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type hsmProviderDataSourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	ClassName     types.String `tfsdk:"classname"`
+	Configuration types.Object `tfsdk:"configuration"`
+}
+
+// Schema defines the schema for the data source.
+func (d *hsmProviderDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an HSM Provider by id or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the HSM Provider. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Exact name of the HSM Provider. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"classname": schema.StringAttribute{
+				Computed: true,
+			},
+			"configuration": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"user": schema.StringAttribute{
+						Computed: true,
+					},
+					"partition": schema.StringAttribute{
+						Computed: true,
+					},
+					"slot_id": schema.StringAttribute{
+						Computed: true,
+					},
+					"library": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *hsmProviderDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hsm_provider"
+}
+
+func (d *hsmProviderDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	d.providerConfig = providerCfg.ProviderConfig
+	d.apiClient = providerCfg.ApiClient
+}
+
+func (d *hsmProviderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state hsmProviderDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.CheckDataSourceLookup(&resp.Diagnostics, state.Id, state.Name, "name") {
+		return
+	}
+
+	var hsmProvider *client.HsmProvider
+	if internaltypes.IsDefined(state.Id) {
+		apiReadHsmProvider, httpResp, err := d.apiClient.HsmProvidersApi.GetHsmProvider(config.AuthContext(ctx, d.providerConfig), state.Id.ValueString()).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an HSM Provider", err, httpResp)
+			return
+		}
+		hsmProvider = apiReadHsmProvider
+	} else {
+		name := state.Name.ValueString()
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.HsmProvider, *http.Response, error) {
+			apiReadHsmProviders, httpResp, err := d.apiClient.HsmProvidersApi.GetHsmProviders(config.AuthContext(ctx, d.providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadHsmProviders.GetItems(), httpResp, nil
+		})
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an HSM Provider", err, httpResp)
+			return
+		}
+		// Only exact matches count
+		var matches []client.HsmProvider
+		for _, item := range items {
+			if item.Name == name {
+				matches = append(matches, item)
+			}
+		}
+		if !config.CheckLookupMatches(&resp.Diagnostics, "HSM Provider", "name \""+name+"\"", len(matches)) {
+			return
+		}
+		hsmProvider = &matches[0]
+	}
+	// Log response JSON
+	responseJson, err := hsmProvider.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response using the resource mapper
+	var model hsmProviderResourceModel
+	readHsmProviderResponse(ctx, hsmProvider, &model, &model, &resp.Diagnostics, types.ObjectNull(map[string]attr.Type{}))
+	// The password isn't returned by PingAccess, so it's left out of the data source
+	configurationAttributes := model.Configuration.Attributes()
+	delete(configurationAttributes, "password")
+	configuration, diags := types.ObjectValue(map[string]attr.Type{
+		"user":      types.StringType,
+		"partition": types.StringType,
+		"slot_id":   types.StringType,
+		"library":   types.StringType,
+	}, configurationAttributes)
+	resp.Diagnostics.Append(diags...)
+	state = hsmProviderDataSourceModel{
+		Id:            model.Id,
+		Name:          model.Name,
+		ClassName:     model.ClassName,
+		Configuration: configuration,
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
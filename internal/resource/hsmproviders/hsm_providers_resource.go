@@ -2,10 +2,11 @@ package hsmprovider
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -38,10 +39,11 @@ type hsmProviderResource struct {
 }
 
 type hsmProviderResourceModel struct {
-	Id            types.String `tfsdk:"id"`
-	ClassName     types.String `tfsdk:"classname"`
-	Configuration types.Object `tfsdk:"configuration"`
-	Name          types.String `tfsdk:"name"`
+	Id            types.String   `tfsdk:"id"`
+	ClassName     types.String   `tfsdk:"classname"`
+	Configuration types.Object   `tfsdk:"configuration"`
+	Name          types.String   `tfsdk:"name"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -109,6 +111,12 @@ func hsmProviderResourceSchema(ctx context.Context, req resource.SchemaRequest,
 	if setOptionalToComputed {
 		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"classname", "name", "configuration"})
 	}
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
 	resp.Schema = schema
 }
 
@@ -195,6 +203,13 @@ func (r *hsmProviderResource) Create(ctx context.Context, req resource.CreateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 	// passplain := plan.Configuration.Attributes()
 	createHsmProvider := client.NewHsmProvider(plan.ClassName.ValueString(), plan.Name.ValueString())
 	err := addOptionalHsmProviderFields(ctx, createHsmProvider, plan)
@@ -207,13 +222,14 @@ func (r *hsmProviderResource) Create(ctx context.Context, req resource.CreateReq
 		tflog.Debug(ctx, "Add request: "+string(requestJson))
 	}
 
-	apiCreateHsmProvider := r.apiClient.HsmProvidersApi.AddHsmProvider(config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiCreateHsmProvider := r.apiClient.HsmProvidersApi.AddHsmProvider(config.AuthContext(ctx, r.providerConfig))
 	apiCreateHsmProvider = apiCreateHsmProvider.HsmProvider(*createHsmProvider)
 	hsmResponse, httpResp, err := r.apiClient.HsmProvidersApi.AddHsmProviderExecute(apiCreateHsmProvider)
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the HsmProvider", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
 	responseJson, err := hsmResponse.MarshalJSON()
 	if err == nil {
 		tflog.Debug(ctx, "Add response: "+string(responseJson))
@@ -223,6 +239,7 @@ func (r *hsmProviderResource) Create(ctx context.Context, req resource.CreateReq
 	var state hsmProviderResourceModel
 
 	readHsmProviderResponse(ctx, hsmResponse, &state, &plan, &resp.Diagnostics, plan.Configuration)
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -242,8 +259,17 @@ func readHsmProvider(ctx context.Context, req resource.ReadRequest, resp *resour
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	apiReadHsmProvider, httpResp, err := apiClient.HsmProvidersApi.GetHsmProvider(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
-
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	apiReadHsmProvider, httpResp, err := apiClient.HsmProvidersApi.GetHsmProvider(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "HSM Provider", httpResp) {
+		return
+	}
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an HsmProvider", err, httpResp)
 		return
@@ -279,11 +305,18 @@ func updateHsmProvider(ctx context.Context, req resource.UpdateRequest, resp *re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Get the current state to see how any attributes are changing
 	var state hsmProviderResourceModel
 	req.State.Get(ctx, &state)
-	UpdateHsmProvider := apiClient.HsmProvidersApi.UpdateHsmProvider(config.ProviderBasicAuthContext(ctx, providerConfig), plan.Id.ValueString())
+	UpdateHsmProvider := apiClient.HsmProvidersApi.UpdateHsmProvider(config.AuthContext(ctx, providerConfig), plan.Id.ValueString())
 	CreateUpdateRequest := client.NewHsmProvider(plan.ClassName.ValueString(), plan.Name.ValueString())
 	err := addOptionalHsmProviderFields(ctx, CreateUpdateRequest, plan)
 	if err != nil {
@@ -300,6 +333,7 @@ func updateHsmProvider(ctx context.Context, req resource.UpdateRequest, resp *re
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating HsmProvider", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 	// Log response JSON
 	responseJson, err := updateHsmProviderResponse.MarshalJSON()
 	if err == nil {
@@ -309,6 +343,7 @@ func updateHsmProvider(ctx context.Context, req resource.UpdateRequest, resp *re
 	readHsmProviderResponse(ctx, updateHsmProviderResponse, &state, &plan, &resp.Diagnostics, plan.Configuration)
 
 	// Update computed values
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -329,27 +364,51 @@ func deleteHsmProvider(ctx context.Context, req resource.DeleteRequest, resp *re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 	switch state.ClassName.ValueString() {
 	case "com.pingidentity.pa.hsm.pkcs11.plugin.PKCS11HsmProvider":
-		httpResp, err := apiClient.HsmProvidersApi.DeleteHsmProvider(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+		httpResp, err := apiClient.HsmProvidersApi.DeleteHsmProvider(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 		if err != nil {
 			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting Safenet HsmProvider", err, httpResp)
 			return
 		}
+		config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 
 	case "com.pingidentity.pa.hsm.cloudhsm.plugin.AwsCloudHsmProvider":
-		httpResp, err := apiClient.HsmProvidersApi.DeleteHsmProvider(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+		httpResp, err := apiClient.HsmProvidersApi.DeleteHsmProvider(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 		if err != nil {
 			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting Aws HsmProvider.\nPlease remove the custom aws jar file from the deploy folder before removing the AwsProvider.", err, httpResp)
 			return
 		}
+		config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 	}
 }
 
 func (r *hsmProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	importLocation(ctx, req, resp)
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
 }
-func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve import ID and save to id attribute, looking the ID up first when importing by name
+	config.ImportByIdOrName(ctx, req, resp, "HSM Provider", func(name string) ([]string, *http.Response, error) {
+		var ids []string
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.HsmProvider, *http.Response, error) {
+			apiReadHsmProviders, httpResp, err := apiClient.HsmProvidersApi.GetHsmProviders(config.AuthContext(ctx, providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadHsmProviders.GetItems(), httpResp, nil
+		})
+		for i := range items {
+			if items[i].Name == name {
+				ids = append(ids, internaltypes.Int64PointerToString(*items[i].Id))
+			}
+		}
+		return ids, httpResp, err
+	})
 }
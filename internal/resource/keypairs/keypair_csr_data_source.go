@@ -0,0 +1,93 @@
+package keypairs
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &keyPairCsrDataSource{}
+	_ datasource.DataSourceWithConfigure = &keyPairCsrDataSource{}
+)
+
+// KeyPairCsrDataSource is a helper function to simplify the provider implementation.
+func KeyPairCsrDataSource() datasource.DataSource {
+	return &keyPairCsrDataSource{}
+}
+
+// keyPairCsrDataSource is the data source implementation.
+type keyPairCsrDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type keyPairCsrDataSourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	KeypairId types.String `tfsdk:"keypair_id"`
+	Csr       types.String `tfsdk:"csr"`
+}
+
+// Schema defines the schema for the data source.
+func (d *keyPairCsrDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a certificate signing request for a Key Pair.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the Key Pair.",
+				Computed:    true,
+			},
+			"keypair_id": schema.StringAttribute{
+				Description: "ID of the Key Pair to generate the certificate signing request for.",
+				Required:    true,
+			},
+			"csr": schema.StringAttribute{
+				Description: "PEM-encoded certificate signing request. It only changes between reads for Key Pairs with non-deterministic signatures, such as EC Key Pairs.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *keyPairCsrDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_keypair_csr"
+}
+
+func (d *keyPairCsrDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	d.providerConfig = providerCfg.ProviderConfig
+	d.apiClient = providerCfg.ApiClient
+}
+
+func (d *keyPairCsrDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state keyPairCsrDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	csr, httpResp, err := d.apiClient.KeyPairsApi.GenerateCsr(config.AuthContext(ctx, d.providerConfig), state.KeypairId.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while generating a certificate signing request for a Key Pair", err, httpResp)
+		return
+	}
+
+	state.Id = state.KeypairId
+	state.Csr = types.StringValue(csr)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,288 @@
+package keypairs
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &keyPairCsrResponseResource{}
+	_ resource.ResourceWithConfigure = &keyPairCsrResponseResource{}
+)
+
+// KeyPairCsrResponseResource is a helper function to simplify the provider implementation.
+func KeyPairCsrResponseResource() resource.Resource {
+	return &keyPairCsrResponseResource{}
+}
+
+// keyPairCsrResponseResource is the resource implementation.
+type keyPairCsrResponseResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type keyPairCsrResponseResourceModel struct {
+	Id                 types.String   `tfsdk:"id"`
+	KeypairId          types.String   `tfsdk:"keypair_id"`
+	FileData           types.String   `tfsdk:"file_data"`
+	ChainCertificates  types.List     `tfsdk:"chain_certificates"`
+	TrustedCertGroupId types.Int64    `tfsdk:"trusted_cert_group_id"`
+	SubjectDn          types.String   `tfsdk:"subject_dn"`
+	IssuerDn           types.String   `tfsdk:"issuer_dn"`
+	SerialNumber       types.String   `tfsdk:"serial_number"`
+	ValidFrom          types.String   `tfsdk:"valid_from"`
+	Expires            types.String   `tfsdk:"expires"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *keyPairCsrResponseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	keyPairCsrResponseResourceSchema(ctx, req, resp)
+}
+
+func keyPairCsrResponseResourceSchema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Imports the CA-signed response to a Key Pair's certificate signing request. Destroying this resource only removes it from Terraform state, as PingAccess can't undo the import.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the Key Pair.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"keypair_id": schema.StringAttribute{
+				Description: "ID of the Key Pair the certificate signing request was generated for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_data": schema.StringAttribute{
+				Description: "Base64-encoded signed certificate, in DER or PEM format. A PEM file may also contain the rest of the chain.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"chain_certificates": schema.ListAttribute{
+				Description: "Base64-encoded certificates of the issuing chain, when they aren't included in file_data.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"trusted_cert_group_id": schema.Int64Attribute{
+				Description: "ID of a Trusted Certificate Group that holds the issuer of the signed certificate.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"subject_dn": schema.StringAttribute{
+				Description: "Subject DN of the Key Pair's certificate.",
+				Computed:    true,
+			},
+			"issuer_dn": schema.StringAttribute{
+				Description: "Issuer DN of the Key Pair's certificate.",
+				Computed:    true,
+			},
+			"serial_number": schema.StringAttribute{
+				Description: "Serial number of the Key Pair's certificate.",
+				Computed:    true,
+			},
+			"valid_from": schema.StringAttribute{
+				Description: "Start of the validity period of the Key Pair's certificate, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"expires": schema.StringAttribute{
+				Description: "End of the validity period of the Key Pair's certificate, in RFC 3339 format.",
+				Computed:    true,
+			},
+		},
+	}
+
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+	})
+	resp.Schema = schema
+}
+
+// Metadata returns the resource type name.
+func (r *keyPairCsrResponseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_keypair_csr_response"
+}
+
+func (r *keyPairCsrResponseResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+
+}
+
+func readKeyPairCsrResponseResponse(ctx context.Context, r *client.KeyPair, state *keyPairCsrResponseResourceModel, expectedValues *keyPairCsrResponseResourceModel, diagnostics *diag.Diagnostics) {
+	state.Id = types.StringValue(internaltypes.Int64PointerToString(*r.Id))
+	// The signed response can't be read back, so keep the configured values
+	state.KeypairId = expectedValues.KeypairId
+	state.FileData = expectedValues.FileData
+	state.ChainCertificates = expectedValues.ChainCertificates
+	state.TrustedCertGroupId = expectedValues.TrustedCertGroupId
+	state.SubjectDn = internaltypes.StringTypeOrNil(r.SubjectDn, false)
+	state.IssuerDn = internaltypes.StringTypeOrNil(r.IssuerDn, false)
+	state.SerialNumber = internaltypes.StringTypeOrNil(r.SerialNumber, false)
+	state.ValidFrom = internaltypes.TimestampTypeOrNil(r.ValidFrom)
+	state.Expires = internaltypes.TimestampTypeOrNil(r.Expires)
+}
+
+func (r *keyPairCsrResponseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan keyPairCsrResponseResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	importCsrResponse := client.NewCSRResponseImportDocView(plan.FileData.ValueString())
+	if internaltypes.IsDefined(plan.ChainCertificates) {
+		var chainCertificates []string
+		resp.Diagnostics.Append(plan.ChainCertificates.ElementsAs(ctx, &chainCertificates, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		importCsrResponse.ChainCertificates = chainCertificates
+	}
+	if internaltypes.IsDefined(plan.TrustedCertGroupId) {
+		importCsrResponse.TrustedCertGroupId = plan.TrustedCertGroupId.ValueInt64Pointer()
+	}
+	requestJson, err := importCsrResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add request: "+string(requestJson))
+	}
+
+	keyPairResponse, httpResp, err := r.apiClient.KeyPairsApi.ImportCsr(config.AuthContext(ctx, r.providerConfig), plan.KeypairId.ValueString()).CSRResponseImportDocView(*importCsrResponse).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while importing the certificate signing request response for the Key Pair", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
+	responseJson, err := keyPairResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	var state keyPairCsrResponseResourceModel
+
+	readKeyPairCsrResponseResponse(ctx, keyPairResponse, &state, &plan, &resp.Diagnostics)
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *keyPairCsrResponseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readKeyPairCsrResponse(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func readKeyPairCsrResponse(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	var state keyPairCsrResponseResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	apiReadKeyPair, httpResp, err := apiClient.KeyPairsApi.GetKeyPair(config.AuthContext(ctx, providerConfig), state.KeypairId.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "Key Pair", httpResp) {
+		return
+	}
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Key Pair", err, httpResp)
+		return
+	}
+	// Log response JSON
+	responseJson, err := apiReadKeyPair.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// A certificate that no longer matches the imported response means the Key Pair was signed again
+	// outside of Terraform, so the response needs to be imported again
+	if !state.SerialNumber.IsNull() && state.SerialNumber.ValueString() != apiReadKeyPair.GetSerialNumber() {
+		tflog.Warn(ctx, "Key Pair certificate no longer matches the imported certificate signing request response, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Read the response into the state
+	readKeyPairCsrResponseResponse(ctx, apiReadKeyPair, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *keyPairCsrResponseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateKeyPairCsrResponse(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func updateKeyPairCsrResponse(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Every configurable attribute requires replacement, so only the timeouts can change here
+	var plan, state keyPairCsrResponseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = plan.Timeouts
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// // Delete deletes the resource and removes the Terraform state on success.
+func (r *keyPairCsrResponseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// PingAccess can't undo the import, and the Key Pair keeps its signed certificate
+	tflog.Warn(ctx, "Removing the certificate signing request response from state. The Key Pair keeps its signed certificate.")
+}
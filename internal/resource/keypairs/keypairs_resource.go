@@ -0,0 +1,617 @@
+package keypairs
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &keyPairResource{}
+	_ resource.ResourceWithConfigure      = &keyPairResource{}
+	_ resource.ResourceWithImportState    = &keyPairResource{}
+	_ resource.ResourceWithValidateConfig = &keyPairResource{}
+)
+
+// KeyPairResource is a helper function to simplify the provider implementation.
+func KeyPairResource() resource.Resource {
+	return &keyPairResource{}
+}
+
+// keyPairResource is the resource implementation.
+type keyPairResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type keyPairResourceModel struct {
+	Id                      types.String   `tfsdk:"id"`
+	Alias                   types.String   `tfsdk:"alias"`
+	FileData                types.String   `tfsdk:"file_data"`
+	Password                types.String   `tfsdk:"password"`
+	Generate                types.Object   `tfsdk:"generate"`
+	HsmProviderId           types.Int64    `tfsdk:"hsm_provider_id"`
+	SubjectDn               types.String   `tfsdk:"subject_dn"`
+	SubjectCn               types.String   `tfsdk:"subject_cn"`
+	IssuerDn                types.String   `tfsdk:"issuer_dn"`
+	SerialNumber            types.String   `tfsdk:"serial_number"`
+	Sha1Fingerprint         types.String   `tfsdk:"sha1_fingerprint"`
+	ValidFrom               types.String   `tfsdk:"valid_from"`
+	Expires                 types.String   `tfsdk:"expires"`
+	SignatureAlgorithm      types.String   `tfsdk:"signature_algorithm"`
+	SubjectAlternativeNames types.List     `tfsdk:"subject_alternative_names"`
+	Status                  types.String   `tfsdk:"status"`
+	CsrPending              types.Bool     `tfsdk:"csr_pending"`
+	ChainCertificates       types.List     `tfsdk:"chain_certificates"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+type keyPairGenerateModel struct {
+	CommonName              types.String `tfsdk:"common_name"`
+	Organization            types.String `tfsdk:"organization"`
+	OrganizationUnit        types.String `tfsdk:"organization_unit"`
+	City                    types.String `tfsdk:"city"`
+	State                   types.String `tfsdk:"state"`
+	Country                 types.String `tfsdk:"country"`
+	KeyAlgorithm            types.String `tfsdk:"key_algorithm"`
+	KeySize                 types.Int64  `tfsdk:"key_size"`
+	SignatureAlgorithm      types.String `tfsdk:"signature_algorithm"`
+	ValidDays               types.Int64  `tfsdk:"valid_days"`
+	SubjectAlternativeNames types.List   `tfsdk:"subject_alternative_names"`
+}
+
+type subjectAlternativeNameModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+// Attribute types of the chain_certificates elements
+var chainCertificateAttrTypes = map[string]attr.Type{
+	"subject_dn":       types.StringType,
+	"issuer_dn":        types.StringType,
+	"serial_number":    types.StringType,
+	"sha1_fingerprint": types.StringType,
+	"valid_from":       types.StringType,
+	"expires":          types.StringType,
+}
+
+// GetSchema defines the schema for the resource.
+func (r *keyPairResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	keyPairResourceSchema(ctx, req, resp)
+}
+
+func keyPairResourceSchema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Manages a Key Pair, either imported from a PKCS#12 file or generated by PingAccess.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"alias": schema.StringAttribute{
+				Description: "Alias of the Key Pair.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_data": schema.StringAttribute{
+				Description: "Base64-encoded PKCS#12 file to import. Exactly one of file_data and generate must be set.",
+				Optional:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(requiresReplaceUnlessImported, "Changing file_data requires a new Key Pair.", "Changing file_data requires a new Key Pair."),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "Password of the PKCS#12 file. Required with file_data.",
+				Optional:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(requiresReplaceUnlessImported, "Changing password requires a new Key Pair.", "Changing password requires a new Key Pair."),
+				},
+			},
+			"generate": schema.SingleNestedAttribute{
+				Description: "Settings used by PingAccess to generate the Key Pair. Exactly one of file_data and generate must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !req.StateValue.IsNull()
+					}, "Changing generate requires a new Key Pair.", "Changing generate requires a new Key Pair."),
+				},
+				Attributes: map[string]schema.Attribute{
+					"common_name": schema.StringAttribute{
+						Description: "Common name of the certificate subject.",
+						Required:    true,
+					},
+					"organization": schema.StringAttribute{
+						Description: "Organization of the certificate subject.",
+						Required:    true,
+					},
+					"organization_unit": schema.StringAttribute{
+						Description: "Organizational unit of the certificate subject.",
+						Optional:    true,
+					},
+					"city": schema.StringAttribute{
+						Description: "City of the certificate subject.",
+						Optional:    true,
+					},
+					"state": schema.StringAttribute{
+						Description: "State of the certificate subject.",
+						Optional:    true,
+					},
+					"country": schema.StringAttribute{
+						Description: "Two-letter country code of the certificate subject.",
+						Required:    true,
+					},
+					"key_algorithm": schema.StringAttribute{
+						Description: "Key algorithm, such as RSA or EC.",
+						Required:    true,
+					},
+					"key_size": schema.Int64Attribute{
+						Description: "Key size in bits.",
+						Required:    true,
+					},
+					"signature_algorithm": schema.StringAttribute{
+						Description: "Algorithm used to sign the certificate, such as SHA256withRSA.",
+						Optional:    true,
+					},
+					"valid_days": schema.Int64Attribute{
+						Description: "Number of days the certificate is valid for.",
+						Required:    true,
+					},
+					"subject_alternative_names": schema.ListNestedAttribute{
+						Description: "Subject alternative names of the certificate.",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Description: "Type of the subject alternative name, such as DNSName or IPAddress.",
+									Required:    true,
+								},
+								"value": schema.StringAttribute{
+									Description: "Value of the subject alternative name.",
+									Required:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"hsm_provider_id": schema.Int64Attribute{
+				Description: "ID of the HSM Provider that stores the Key Pair.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"subject_dn": schema.StringAttribute{
+				Description: "Subject DN of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subject_cn": schema.StringAttribute{
+				Description: "Subject common name of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issuer_dn": schema.StringAttribute{
+				Description: "Issuer DN of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"serial_number": schema.StringAttribute{
+				Description: "Serial number of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sha1_fingerprint": schema.StringAttribute{
+				Description: "SHA-1 fingerprint of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"valid_from": schema.StringAttribute{
+				Description: "Start of the certificate's validity period, in RFC 3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires": schema.StringAttribute{
+				Description: "End of the certificate's validity period, in RFC 3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"signature_algorithm": schema.StringAttribute{
+				Description: "Algorithm used to sign the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subject_alternative_names": schema.ListNestedAttribute{
+				Description: "Subject alternative names of the certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Type of the subject alternative name, such as DNSName or IPAddress.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "Value of the subject alternative name.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the certificate, such as Valid or Expired.",
+				Computed:    true,
+			},
+			"csr_pending": schema.BoolAttribute{
+				Description: "Whether a certificate signing request has been generated for the Key Pair and not yet answered.",
+				Computed:    true,
+			},
+			"chain_certificates": schema.ListNestedAttribute{
+				Description: "Certificates in the Key Pair's chain, other than its own certificate.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"subject_dn": schema.StringAttribute{
+							Computed: true,
+						},
+						"issuer_dn": schema.StringAttribute{
+							Computed: true,
+						},
+						"serial_number": schema.StringAttribute{
+							Computed: true,
+						},
+						"sha1_fingerprint": schema.StringAttribute{
+							Computed: true,
+						},
+						"valid_from": schema.StringAttribute{
+							Computed: true,
+						},
+						"expires": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Delete: true,
+	})
+	resp.Schema = schema
+}
+
+// Key material can't be read back from PingAccess, so an imported Key Pair has none in state. Don't replace the
+// Key Pair when its configuration first sets it.
+func requiresReplaceUnlessImported(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+// Metadata returns the resource type name.
+func (r *keyPairResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_keypair"
+}
+
+func (r *keyPairResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+
+}
+
+func (r *keyPairResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model keyPairResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() || model.FileData.IsUnknown() || model.Generate.IsUnknown() {
+		return
+	}
+	if model.FileData.IsNull() == model.Generate.IsNull() {
+		resp.Diagnostics.AddError("Invalid Key Pair configuration", "Exactly one of file_data and generate must be set")
+	}
+	if !model.FileData.IsNull() && model.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("password"), "Missing Key Pair password", "password must be set when importing a Key Pair from file_data")
+	}
+}
+
+func readKeyPairResponse(ctx context.Context, r *client.KeyPair, state *keyPairResourceModel, expectedValues *keyPairResourceModel, diagnostics *diag.Diagnostics) {
+	state.Id = types.StringValue(internaltypes.Int64PointerToString(*r.Id))
+	state.Alias = types.StringValue(r.Alias)
+	// Key material isn't returned by the admin API
+	state.FileData = expectedValues.FileData
+	state.Password = expectedValues.Password
+	state.Generate = expectedValues.Generate
+	state.HsmProviderId = internaltypes.Int64TypeOrNil(r.HsmProviderId)
+	state.SubjectDn = internaltypes.StringTypeOrNil(r.SubjectDn, false)
+	state.SubjectCn = internaltypes.StringTypeOrNil(r.SubjectCn, false)
+	state.IssuerDn = internaltypes.StringTypeOrNil(r.IssuerDn, false)
+	state.SerialNumber = internaltypes.StringTypeOrNil(r.SerialNumber, false)
+	state.Sha1Fingerprint = internaltypes.StringTypeOrNil(r.Sha1sum, false)
+	state.ValidFrom = internaltypes.TimestampTypeOrNil(r.ValidFrom)
+	state.Expires = internaltypes.TimestampTypeOrNil(r.Expires)
+	state.SignatureAlgorithm = internaltypes.StringTypeOrNil(r.SignatureAlgorithm, false)
+	state.SubjectAlternativeNames = config.GetSubjectAlternativeNames(r.SubjectAlternativeNames, diagnostics)
+	state.Status = internaltypes.StringTypeOrNil(r.Status, false)
+	state.CsrPending = internaltypes.BoolTypeOrNil(r.CsrPending)
+
+	chainCertificates := []attr.Value{}
+	for _, chainCertificate := range r.ChainCertificates {
+		chainCertificateValue, diags := types.ObjectValue(chainCertificateAttrTypes, map[string]attr.Value{
+			"subject_dn":       internaltypes.StringTypeOrNil(chainCertificate.SubjectDn, false),
+			"issuer_dn":        internaltypes.StringTypeOrNil(chainCertificate.IssuerDn, false),
+			"serial_number":    internaltypes.StringTypeOrNil(chainCertificate.SerialNumber, false),
+			"sha1_fingerprint": internaltypes.StringTypeOrNil(chainCertificate.Sha1sum, false),
+			"valid_from":       internaltypes.TimestampTypeOrNil(chainCertificate.ValidFrom),
+			"expires":          internaltypes.TimestampTypeOrNil(chainCertificate.Expires),
+		})
+		diagnostics.Append(diags...)
+		chainCertificates = append(chainCertificates, chainCertificateValue)
+	}
+	chainCertificateList, diags := types.ListValue(types.ObjectType{AttrTypes: chainCertificateAttrTypes}, chainCertificates)
+	diagnostics.Append(diags...)
+	state.ChainCertificates = chainCertificateList
+}
+
+// Build the request used to have PingAccess generate a Key Pair
+func createGenerateRequest(ctx context.Context, plan keyPairResourceModel, diagnostics *diag.Diagnostics) *client.NewKeyPairConfig {
+	var generate keyPairGenerateModel
+	diagnostics.Append(plan.Generate.As(ctx, &generate, basetypes.ObjectAsOptions{})...)
+	if diagnostics.HasError() {
+		return nil
+	}
+	generateRequest := client.NewNewKeyPairConfig(plan.Alias.ValueString(), generate.CommonName.ValueString(), generate.Country.ValueString(),
+		generate.KeyAlgorithm.ValueString(), generate.KeySize.ValueInt64(), generate.Organization.ValueString(), generate.ValidDays.ValueInt64())
+	generateRequest.OrganizationUnit = generate.OrganizationUnit.ValueStringPointer()
+	generateRequest.City = generate.City.ValueStringPointer()
+	generateRequest.State = generate.State.ValueStringPointer()
+	generateRequest.SignatureAlgorithm = generate.SignatureAlgorithm.ValueStringPointer()
+	if internaltypes.IsDefined(plan.HsmProviderId) {
+		generateRequest.HsmProviderId = plan.HsmProviderId.ValueInt64Pointer()
+	}
+	if internaltypes.IsDefined(generate.SubjectAlternativeNames) {
+		var sans []subjectAlternativeNameModel
+		diagnostics.Append(generate.SubjectAlternativeNames.ElementsAs(ctx, &sans, false)...)
+		for _, san := range sans {
+			generateRequest.SubjectAlternativeNames = append(generateRequest.SubjectAlternativeNames, client.SanType{
+				Name:  san.Name.ValueStringPointer(),
+				Value: san.Value.ValueStringPointer(),
+			})
+		}
+	}
+	return generateRequest
+}
+
+func (r *keyPairResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan keyPairResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var keyPairResponse *client.KeyPair
+	var httpResp *http.Response
+	var err error
+	if internaltypes.IsDefined(plan.FileData) {
+		// The import request holds the private key and its password, so it isn't logged
+		importRequest := client.NewPKCS12FileImportDoc(plan.Alias.ValueString(), plan.FileData.ValueString())
+		importRequest.Password = client.NewHiddenField()
+		importRequest.Password.Value = plan.Password.ValueStringPointer()
+		if internaltypes.IsDefined(plan.HsmProviderId) {
+			importRequest.HsmProviderId = plan.HsmProviderId.ValueInt64Pointer()
+		}
+		keyPairResponse, httpResp, err = r.apiClient.KeyPairsApi.ImportKeyPair(config.AuthContext(ctx, r.providerConfig)).PKCS12File(*importRequest).Execute()
+	} else {
+		generateRequest := createGenerateRequest(ctx, plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		requestJson, jsonErr := generateRequest.MarshalJSON()
+		if jsonErr == nil {
+			tflog.Debug(ctx, "Add request: "+string(requestJson))
+		}
+		keyPairResponse, httpResp, err = r.apiClient.KeyPairsApi.GenerateKeyPair(config.AuthContext(ctx, r.providerConfig)).NewKeyPairConfig(*generateRequest).Execute()
+	}
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Key Pair", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
+	responseJson, err := keyPairResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	var state keyPairResourceModel
+
+	readKeyPairResponse(ctx, keyPairResponse, &state, &plan, &resp.Diagnostics)
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *keyPairResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readKeyPair(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func readKeyPair(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	var state keyPairResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	apiReadKeyPair, httpResp, err := apiClient.KeyPairsApi.GetKeyPair(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "Key Pair", httpResp) {
+		return
+	}
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Key Pair", err, httpResp)
+		return
+	}
+	// Log response JSON
+	responseJson, err := apiReadKeyPair.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	readKeyPairResponse(ctx, apiReadKeyPair, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *keyPairResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateKeyPair(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func updateKeyPair(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Every change to the Key Pair itself requires replacement. Updates only store the key material
+	// configured for an imported Key Pair, along with the timeouts, and refresh the computed attributes.
+	var plan keyPairResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReadKeyPair, httpResp, err := apiClient.KeyPairsApi.GetKeyPair(config.AuthContext(ctx, providerConfig), plan.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Key Pair", err, httpResp)
+		return
+	}
+
+	var state keyPairResourceModel
+	readKeyPairResponse(ctx, apiReadKeyPair, &state, &plan, &resp.Diagnostics)
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// // Delete deletes the resource and removes the Terraform state on success.
+func (r *keyPairResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	deleteKeyPair(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+func deleteKeyPair(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from state
+	var state keyPairResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	httpResp, err := apiClient.KeyPairsApi.DeleteKeyPair(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting a Key Pair", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
+
+}
+
+func (r *keyPairResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve import ID and save to id attribute, looking the ID up first when importing by alias
+	config.ImportByIdOrLookup(ctx, req, resp, "Key Pair", config.ImportByAliasPrefix, "alias", func(alias string) ([]string, *http.Response, error) {
+		var ids []string
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.KeyPair, *http.Response, error) {
+			apiReadKeyPairs, httpResp, err := apiClient.KeyPairsApi.GetKeyPairs(config.AuthContext(ctx, providerConfig)).Alias(alias).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadKeyPairs.GetItems(), httpResp, nil
+		})
+		for i := range items {
+			if items[i].Alias == alias {
+				ids = append(ids, internaltypes.Int64PointerToString(*items[i].Id))
+			}
+		}
+		return ids, httpResp, err
+	})
+}
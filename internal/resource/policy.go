@@ -0,0 +1,123 @@
+package config
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	client "github.com/pingidentity/pingaccess-go-client"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+type policyModel struct {
+	Web types.List `tfsdk:"web"`
+	Api types.List `tfsdk:"api"`
+}
+
+type policyItemModel struct {
+	Id   types.Int64  `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+
+// Attribute types of policy attributes, which hold the Rules and Rule Sets applied to Web and API requests
+var (
+	policyItemAttrTypes = map[string]attr.Type{
+		"id":   types.Int64Type,
+		"type": types.StringType,
+	}
+	policyAttrTypes = map[string]attr.Type{
+		"web": types.ListType{ElemType: types.ObjectType{AttrTypes: policyItemAttrTypes}},
+		"api": types.ListType{ElemType: types.ObjectType{AttrTypes: policyItemAttrTypes}},
+	}
+	// PingAccess policy keys of each policy attribute
+	policyKeys = map[string]string{
+		"web": "Web",
+		"api": "API",
+	}
+)
+
+func policyItemsAttribute(policyType string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "Rules and Rule Sets applied to " + policyType + " requests, in order.",
+		Optional:    true,
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.Int64Attribute{
+					Description: "ID of the Rule or Rule Set.",
+					Required:    true,
+				},
+				"type": schema.StringAttribute{
+					Description: "Either Rule or RuleSet.",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// Schema of a policy attribute
+func PolicyAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"web": policyItemsAttribute("Web"),
+			"api": policyItemsAttribute("API"),
+		},
+	}
+}
+
+// Read a policy returned by PingAccess into a policy attribute value
+func GetPolicy(policy map[string][]client.PolicyItem, diagnostics *diag.Diagnostics) types.Object {
+	policyValues := map[string]attr.Value{}
+	for attribute, key := range policyKeys {
+		items := []attr.Value{}
+		for _, policyItem := range policy[key] {
+			item, diags := types.ObjectValue(policyItemAttrTypes, map[string]attr.Value{
+				"id":   internaltypes.Int64TypeOrNil(policyItem.Id),
+				"type": internaltypes.StringTypeOrNil(policyItem.Type, false),
+			})
+			diagnostics.Append(diags...)
+			items = append(items, item)
+		}
+		itemList, diags := types.ListValue(types.ObjectType{AttrTypes: policyItemAttrTypes}, items)
+		diagnostics.Append(diags...)
+		policyValues[attribute] = itemList
+	}
+	policyValue, diags := types.ObjectValue(policyAttrTypes, policyValues)
+	diagnostics.Append(diags...)
+	return policyValue
+}
+
+// Build the policy sent to PingAccess from a policy attribute value. Unset policy types are left out.
+func GetPolicyRequest(ctx context.Context, policy types.Object) map[string][]client.PolicyItem {
+	var model policyModel
+	policy.As(ctx, &model, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})
+	policyRequest := map[string][]client.PolicyItem{}
+	for attribute, items := range map[string]types.List{"web": model.Web, "api": model.Api} {
+		if !internaltypes.IsDefined(items) {
+			continue
+		}
+		var policyItems []policyItemModel
+		items.ElementsAs(ctx, &policyItems, false)
+		requestItems := []client.PolicyItem{}
+		for _, policyItem := range policyItems {
+			requestItems = append(requestItems, client.PolicyItem{
+				Id:   policyItem.Id.ValueInt64Pointer(),
+				Type: policyItem.Type.ValueStringPointer(),
+			})
+		}
+		policyRequest[policyKeys[attribute]] = requestItems
+	}
+	return policyRequest
+}
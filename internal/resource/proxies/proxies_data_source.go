@@ -0,0 +1,159 @@
+package proxie
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &httpClientProxyDataSource{}
+	_ datasource.DataSourceWithConfigure = &httpClientProxyDataSource{}
+)
+
+// HttpClientProxyDataSource is a helper function to simplify the provider implementation.
+func HttpClientProxyDataSource() datasource.DataSource {
+	return &httpClientProxyDataSource{}
+}
+
+// httpClientProxyDataSource is the data source implementation.
+type httpClientProxyDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type httpClientProxyDataSourceModel struct {
+	Id                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Description            types.String `tfsdk:"description"`
+	Host                   types.String `tfsdk:"host"`
+	Port                   types.Int64  `tfsdk:"port"`
+	RequiresAuthentication types.Bool   `tfsdk:"requires_authentication"`
+	Username               types.String `tfsdk:"username"`
+}
+
+// Schema defines the schema for the data source.
+func (d *httpClientProxyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an HTTP Client Proxy by id or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the HTTP Client Proxy. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Exact name of the HTTP Client Proxy. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"host": schema.StringAttribute{
+				Computed: true,
+			},
+			"port": schema.Int64Attribute{
+				Computed: true,
+			},
+			"requires_authentication": schema.BoolAttribute{
+				Computed: true,
+			},
+			"username": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *httpClientProxyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_proxy"
+}
+
+func (d *httpClientProxyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	d.providerConfig = providerCfg.ProviderConfig
+	d.apiClient = providerCfg.ApiClient
+}
+
+func (d *httpClientProxyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state httpClientProxyDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.CheckDataSourceLookup(&resp.Diagnostics, state.Id, state.Name, "name") {
+		return
+	}
+
+	var httpClientProxy *client.HttpClientProxy
+	if internaltypes.IsDefined(state.Id) {
+		apiReadHttpClientProxy, httpResp, err := d.apiClient.ProxiesApi.GetProxy(config.AuthContext(ctx, d.providerConfig), state.Id.ValueString()).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an HTTP Client Proxy", err, httpResp)
+			return
+		}
+		httpClientProxy = apiReadHttpClientProxy
+	} else {
+		name := state.Name.ValueString()
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.HttpClientProxy, *http.Response, error) {
+			apiReadHttpClientProxies, httpResp, err := d.apiClient.ProxiesApi.GetProxies(config.AuthContext(ctx, d.providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadHttpClientProxies.GetItems(), httpResp, nil
+		})
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for an HTTP Client Proxy", err, httpResp)
+			return
+		}
+		// Only exact matches count
+		var matches []client.HttpClientProxy
+		for _, item := range items {
+			if item.Name == name {
+				matches = append(matches, item)
+			}
+		}
+		if !config.CheckLookupMatches(&resp.Diagnostics, "HTTP Client Proxy", "name \""+name+"\"", len(matches)) {
+			return
+		}
+		httpClientProxy = &matches[0]
+	}
+	// Log response JSON
+	responseJson, err := httpClientProxy.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response using the resource mapper
+	var model proxieResourceModel
+	readHttpClientProxyResponse(ctx, httpClientProxy, &model, &model, types.ObjectNull(map[string]attr.Type{}), false)
+	state = httpClientProxyDataSourceModel{
+		Id:                     model.Id,
+		Name:                   model.Name,
+		Description:            model.Description,
+		Host:                   model.Host,
+		Port:                   model.Port,
+		RequiresAuthentication: model.RequiresAuthentication,
+		Username:               model.Username,
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
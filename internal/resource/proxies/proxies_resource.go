@@ -2,10 +2,11 @@ package proxie
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -37,14 +38,15 @@ type proxieResource struct {
 }
 
 type proxieResourceModel struct {
-	Id                     types.String `tfsdk:"id"`
-	Description            types.String `tfsdk:"description"`
-	Host                   types.String `tfsdk:"host"`
-	Name                   types.String `tfsdk:"name"`
-	Password               types.Object `tfsdk:"password"`
-	Port                   types.Int64  `tfsdk:"port"`
-	RequiresAuthentication types.Bool   `tfsdk:"requires_authentication"`
-	Username               types.String `tfsdk:"username"`
+	Id                     types.String   `tfsdk:"id"`
+	Description            types.String   `tfsdk:"description"`
+	Host                   types.String   `tfsdk:"host"`
+	Name                   types.String   `tfsdk:"name"`
+	Password               types.Object   `tfsdk:"password"`
+	Port                   types.Int64    `tfsdk:"port"`
+	RequiresAuthentication types.Bool     `tfsdk:"requires_authentication"`
+	Username               types.String   `tfsdk:"username"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -102,6 +104,12 @@ func proxieResourceSchema(ctx context.Context, req resource.SchemaRequest, resp
 	if setOptionalToComputed {
 		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"name", "host", "port"})
 	}
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
 	resp.Schema = schema
 }
 func addOptionalHttpClientProxyFields(ctx context.Context, addRequest *client.HttpClientProxy, plan proxieResourceModel) error {
@@ -178,6 +186,13 @@ func (r *proxieResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	createHttpClientProxy := client.NewHttpClientProxy(plan.Name.ValueString(), plan.Host.ValueString(), plan.Port.ValueInt64())
 	err := addOptionalHttpClientProxyFields(ctx, createHttpClientProxy, plan)
@@ -190,13 +205,14 @@ func (r *proxieResource) Create(ctx context.Context, req resource.CreateRequest,
 		tflog.Debug(ctx, "Add request: "+string(requestJson))
 	}
 
-	apiCreateHttpClientProxy := r.apiClient.ProxiesApi.AddProxy(config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiCreateHttpClientProxy := r.apiClient.ProxiesApi.AddProxy(config.AuthContext(ctx, r.providerConfig))
 	apiCreateHttpClientProxy = apiCreateHttpClientProxy.Proxy(*createHttpClientProxy)
 	proxieResponse, httpResp, err := r.apiClient.ProxiesApi.AddProxyExecute(apiCreateHttpClientProxy)
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the HttpClientProxy", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
 	responseJson, err := proxieResponse.MarshalJSON()
 	if err == nil {
 		tflog.Debug(ctx, "Add response: "+string(responseJson))
@@ -206,6 +222,7 @@ func (r *proxieResource) Create(ctx context.Context, req resource.CreateRequest,
 	var state proxieResourceModel
 
 	readHttpClientProxyResponse(ctx, proxieResponse, &state, &plan, plan.Password, true)
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -224,8 +241,18 @@ func readHttpClientProxy(ctx context.Context, req resource.ReadRequest, resp *re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
-	apiReadHttpClientProxy, httpResp, err := apiClient.ProxiesApi.GetProxy(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	apiReadHttpClientProxy, httpResp, err := apiClient.ProxiesApi.GetProxy(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "HTTP Client Proxy", httpResp) {
+		return
+	}
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a HttpClientProxy", err, httpResp)
 		return
@@ -261,12 +288,19 @@ func updateHttpClientProxy(ctx context.Context, req resource.UpdateRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Get the current state to see how any attributes are changing
 	var state proxieResourceModel
 	req.State.Get(ctx, &state)
 
-	UpdateHttpClientProxy := apiClient.ProxiesApi.UpdateProxy(config.ProviderBasicAuthContext(ctx, providerConfig), plan.Id.ValueString())
+	UpdateHttpClientProxy := apiClient.ProxiesApi.UpdateProxy(config.AuthContext(ctx, providerConfig), plan.Id.ValueString())
 	CreateUpdateRequest := client.NewHttpClientProxy(plan.Name.ValueString(), plan.Host.ValueString(), plan.Port.ValueInt64())
 	err := addOptionalHttpClientProxyFields(ctx, CreateUpdateRequest, plan)
 	if err != nil {
@@ -283,6 +317,7 @@ func updateHttpClientProxy(ctx context.Context, req resource.UpdateRequest, resp
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating HttpClientProxy", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 	// Log response JSON
 	responseJson, err := updateHttpClientProxyResponse.MarshalJSON()
 	if err == nil {
@@ -292,6 +327,7 @@ func updateHttpClientProxy(ctx context.Context, req resource.UpdateRequest, resp
 	readHttpClientProxyResponse(ctx, updateHttpClientProxyResponse, &state, &plan, plan.Password, true)
 
 	// Update computed values
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -312,18 +348,41 @@ func deleteHttpClientProxy(ctx context.Context, req resource.DeleteRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	httpResp, err := apiClient.ProxiesApi.DeleteProxy(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	httpResp, err := apiClient.ProxiesApi.DeleteProxy(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting a HttpClientProxy", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 
 }
 
 func (r *proxieResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	importLocation(ctx, req, resp)
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
 }
-func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve import ID and save to id attribute, looking the ID up first when importing by name
+	config.ImportByIdOrName(ctx, req, resp, "HTTP Client Proxy", func(name string) ([]string, *http.Response, error) {
+		var ids []string
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.HttpClientProxy, *http.Response, error) {
+			apiReadHttpClientProxies, httpResp, err := apiClient.ProxiesApi.GetProxies(config.AuthContext(ctx, providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadHttpClientProxies.GetItems(), httpResp, nil
+		})
+		for i := range items {
+			if items[i].Name == name {
+				ids = append(ids, internaltypes.Int64PointerToString(*items[i].Id))
+			}
+		}
+		return ids, httpResp, err
+	})
 }
@@ -0,0 +1,219 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingaccess-go-client"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Descriptor field types that hold structured values
+const (
+	fieldTypeTable     = "TABLE"
+	fieldTypeComposite = "COMPOSITE"
+	fieldTypeCheckbox  = "CHECKBOX"
+	fieldTypeList      = "LIST"
+)
+
+// Descriptor field types whose values must be one of the field's options
+var optionFieldTypes = map[string]bool{
+	"SELECT":             true,
+	"RADIO_BUTTON":       true,
+	"AUTOCOMPLETECLOSED": true,
+}
+
+// Parse a JSON encoded Rule configuration
+func parseConfiguration(configuration string) (map[string]interface{}, error) {
+	var configValues map[string]interface{}
+	err := json.Unmarshal([]byte(configuration), &configValues)
+	if err != nil {
+		return nil, fmt.Errorf("configuration must be a JSON object: %w", err)
+	}
+	if configValues == nil {
+		return nil, fmt.Errorf("configuration must be a JSON object")
+	}
+	return configValues, nil
+}
+
+// Check whether the configuration in state already holds every value of the planned configuration
+func configurationAlreadyApplied(stateConfiguration, planConfiguration types.String) bool {
+	if !internaltypes.IsDefined(stateConfiguration) || !internaltypes.IsDefined(planConfiguration) {
+		return false
+	}
+	stateValues, err := parseConfiguration(stateConfiguration.ValueString())
+	if err != nil {
+		return false
+	}
+	planValues, err := parseConfiguration(planConfiguration.ValueString())
+	if err != nil {
+		return false
+	}
+	return configurationContains(stateValues, planValues)
+}
+
+// Check whether every field of the expected configuration has the same value in the actual configuration.
+// Concealed values are returned encrypted by PingAccess, so they can't be compared.
+func configurationContains(actual, expected map[string]interface{}) bool {
+	for name, expectedValue := range expected {
+		actualValue, ok := actual[name]
+		if !ok {
+			return false
+		}
+		if actualObject, ok := actualValue.(map[string]interface{}); ok {
+			if _, encrypted := actualObject["encryptedValue"]; encrypted {
+				continue
+			}
+		}
+		if !configurationValueEqual(actualValue, expectedValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// PingAccess may return scalar configuration values as strings, so "10" and 10 are treated as equal
+func configurationValueEqual(actual, expected interface{}) bool {
+	if reflect.DeepEqual(actual, expected) {
+		return true
+	}
+	switch actual.(type) {
+	case map[string]interface{}, []interface{}, nil:
+		return false
+	}
+	switch expected.(type) {
+	case map[string]interface{}, []interface{}, nil:
+		return false
+	}
+	return fmt.Sprint(actual) == fmt.Sprint(expected)
+}
+
+// Validate a Rule configuration against the descriptor of its class
+func validateRuleConfiguration(className, configuration string, descriptors []client.RuleDescriptor, diagnostics *diag.Diagnostics) {
+	var classNames []string
+	for _, descriptor := range descriptors {
+		if descriptor.ClassName != className {
+			classNames = append(classNames, descriptor.ClassName)
+			continue
+		}
+		configValues, err := parseConfiguration(configuration)
+		if err != nil {
+			diagnostics.AddAttributeError(path.Root("configuration"), "Invalid Rule configuration", err.Error())
+			return
+		}
+		validateConfigurationFields("", descriptor.GetConfigurationFields(), configValues, diagnostics)
+		return
+	}
+	sort.Strings(classNames)
+	diagnostics.AddAttributeError(path.Root("classname"), "Invalid Rule classname",
+		fmt.Sprintf("PingAccess has no Rule type with class name %s. Available class names are: %s", className, strings.Join(classNames, ", ")))
+}
+
+// Validate configuration values against descriptor fields. prefix locates nested values in error messages.
+func validateConfigurationFields(prefix string, fields []client.ConfigurationField, values map[string]interface{}, diagnostics *diag.Diagnostics) {
+	fieldsByName := map[string]client.ConfigurationField{}
+	for _, field := range fields {
+		fieldsByName[field.Name] = field
+		if _, ok := values[field.Name]; !ok && field.GetRequired() && !field.HasDefault() {
+			diagnostics.AddAttributeError(path.Root("configuration"), "Missing Rule configuration field",
+				fmt.Sprintf("Configuration field %s%s is required", prefix, field.Name))
+		}
+	}
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		field, ok := fieldsByName[name]
+		if !ok {
+			diagnostics.AddAttributeError(path.Root("configuration"), "Unknown Rule configuration field",
+				fmt.Sprintf("Configuration field %s%s is not supported. Supported fields are: %s", prefix, name, fieldNames(fields)))
+			continue
+		}
+		validateConfigurationValue(prefix+name, field, values[name], diagnostics)
+	}
+}
+
+func validateConfigurationValue(fieldPath string, field client.ConfigurationField, value interface{}, diagnostics *diag.Diagnostics) {
+	if value == nil {
+		return
+	}
+	fieldType := field.GetType()
+	switch {
+	case fieldType == fieldTypeTable:
+		rows, ok := value.([]interface{})
+		if !ok {
+			addInvalidValueError(fieldPath, "must be a list of rows", diagnostics)
+			return
+		}
+		for i, row := range rows {
+			rowValues, ok := row.(map[string]interface{})
+			if !ok {
+				addInvalidValueError(fmt.Sprintf("%s[%d]", fieldPath, i), "must be an object", diagnostics)
+				continue
+			}
+			validateConfigurationFields(fmt.Sprintf("%s[%d].", fieldPath, i), field.GetFields(), rowValues, diagnostics)
+		}
+	case fieldType == fieldTypeComposite:
+		compositeValues, ok := value.(map[string]interface{})
+		if !ok {
+			addInvalidValueError(fieldPath, "must be an object", diagnostics)
+			return
+		}
+		validateConfigurationFields(fieldPath+".", field.GetFields(), compositeValues, diagnostics)
+	case fieldType == fieldTypeCheckbox:
+		if _, ok := value.(bool); !ok && value != "true" && value != "false" {
+			addInvalidValueError(fieldPath, "must be true or false", diagnostics)
+		}
+	case fieldType == fieldTypeList || field.GetMultiple():
+		items, ok := value.([]interface{})
+		if !ok {
+			addInvalidValueError(fieldPath, "must be a list", diagnostics)
+			return
+		}
+		if optionFieldTypes[fieldType] {
+			for i, item := range items {
+				validateOption(fmt.Sprintf("%s[%d]", fieldPath, i), field, item, diagnostics)
+			}
+		}
+	case optionFieldTypes[fieldType]:
+		validateOption(fieldPath, field, value, diagnostics)
+	}
+}
+
+func validateOption(fieldPath string, field client.ConfigurationField, value interface{}, diagnostics *diag.Diagnostics) {
+	options := field.GetOptions()
+	// Some fields have their options loaded dynamically, and their descriptors list none
+	if len(options) == 0 {
+		return
+	}
+	var optionValues []string
+	for _, option := range options {
+		if fmt.Sprint(value) == option.Value {
+			return
+		}
+		optionValues = append(optionValues, option.Value)
+	}
+	addInvalidValueError(fieldPath, "must be one of: "+strings.Join(optionValues, ", "), diagnostics)
+}
+
+func addInvalidValueError(fieldPath, detail string, diagnostics *diag.Diagnostics) {
+	diagnostics.AddAttributeError(path.Root("configuration"), "Invalid Rule configuration value",
+		fmt.Sprintf("Configuration field %s %s", fieldPath, detail))
+}
+
+func fieldNames(fields []client.ConfigurationField) string {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		names = append(names, field.Name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
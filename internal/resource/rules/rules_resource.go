@@ -0,0 +1,389 @@
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ruleResource{}
+	_ resource.ResourceWithConfigure   = &ruleResource{}
+	_ resource.ResourceWithImportState = &ruleResource{}
+	_ resource.ResourceWithModifyPlan  = &ruleResource{}
+)
+
+// RuleResource is a helper function to simplify the provider implementation.
+func RuleResource() resource.Resource {
+	return &ruleResource{}
+}
+
+// ruleResource is the resource implementation.
+type ruleResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+// Private state key marking a Rule whose configuration in state was read by an import
+const importedKey = "imported"
+
+type ruleResourceModel struct {
+	Id            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	ClassName     types.String   `tfsdk:"classname"`
+	Configuration types.String   `tfsdk:"configuration"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *ruleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ruleResourceSchema(ctx, req, resp, false)
+}
+
+func ruleResourceSchema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse, setOptionalToComputed bool) {
+	schema := schema.Schema{
+		Description: "Manages a Rule.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"classname": schema.StringAttribute{
+				Description: "Class name of the Rule type, such as com.pingidentity.pa.policy.CIDRPolicyInterceptor for a Network Range rule.",
+				Required:    true,
+			},
+			"configuration": schema.StringAttribute{
+				Description: "JSON encoded configuration of the Rule, using the field names from the Rule type's descriptor. " +
+					"The configuration is validated against the descriptors read from the PingAccess server when planning. " +
+					"After an import this holds the full configuration returned by PingAccess, so the next plan shows an update to the configured subset, which is applied without changing the Rule on the server.",
+				Required: true,
+			},
+		},
+	}
+
+	if setOptionalToComputed {
+		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"classname", "name", "configuration"})
+	}
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+	resp.Schema = schema
+}
+
+func addOptionalRuleFields(ctx context.Context, addRequest *client.Rule, plan ruleResourceModel) error {
+	if internaltypes.IsDefined(plan.Id) {
+		addRequest.Id = internaltypes.StringToInt64Pointer(plan.Id)
+	}
+	configuration, err := parseConfiguration(plan.Configuration.ValueString())
+	if err != nil {
+		return err
+	}
+	addRequest.Configuration = &configuration
+	return nil
+}
+
+// Metadata returns the resource type name.
+func (r *ruleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rule"
+}
+
+func (r *ruleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+
+}
+
+func (r *ruleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate when destroying the resource, or before the provider has been configured
+	if req.Plan.Raw.IsNull() || r.apiClient == nil {
+		return
+	}
+	var plan ruleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !internaltypes.IsDefined(plan.ClassName) || !internaltypes.IsDefined(plan.Configuration) {
+		return
+	}
+	ruleDescriptors, httpResp, err := r.apiClient.RulesApi.GetRuleDescriptors(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while reading Rule descriptors", err, httpResp)
+		return
+	}
+	validateRuleConfiguration(plan.ClassName.ValueString(), plan.Configuration.ValueString(), ruleDescriptors.GetItems(), &resp.Diagnostics)
+}
+
+func readRuleResponse(ctx context.Context, r *client.Rule, state *ruleResourceModel, expectedValues *ruleResourceModel, diagnostics *diag.Diagnostics) {
+	state.Id = types.StringValue(internaltypes.Int64PointerToString(*r.Id))
+	state.Name = types.StringValue(r.Name)
+	state.ClassName = types.StringValue(r.ClassName)
+
+	// Keep the configured JSON when PingAccess returns the same values for every configured field, so that
+	// formatting and fields defaulted by PingAccess don't show up as differences
+	configValues := r.GetConfiguration()
+	if internaltypes.IsDefined(expectedValues.Configuration) {
+		expectedConfig, err := parseConfiguration(expectedValues.Configuration.ValueString())
+		if err == nil && configurationContains(configValues, expectedConfig) {
+			state.Configuration = expectedValues.Configuration
+			return
+		}
+	}
+	configJson, err := json.Marshal(configValues)
+	if err != nil {
+		diagnostics.AddError("Failed to encode the configuration of Rule "+r.Name, err.Error())
+		return
+	}
+	state.Configuration = types.StringValue(string(configJson))
+}
+
+func (r *ruleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ruleResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	createRule := client.NewRule(plan.ClassName.ValueString(), plan.Name.ValueString())
+	err := addOptionalRuleFields(ctx, createRule, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add optional properties to add request for Rule", err.Error())
+		return
+	}
+	requestJson, err := createRule.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add request: "+string(requestJson))
+	}
+	apiCreateRule := r.apiClient.RulesApi.AddRule(config.AuthContext(ctx, r.providerConfig))
+	apiCreateRule = apiCreateRule.Rule(*createRule)
+	ruleResponse, httpResp, err := r.apiClient.RulesApi.AddRuleExecute(apiCreateRule)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating a Rule", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
+	responseJson, err := ruleResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	var state ruleResourceModel
+
+	readRuleResponse(ctx, ruleResponse, &state, &plan, &resp.Diagnostics)
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ruleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readRule(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func readRule(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	var state ruleResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	apiReadRule, httpResp, err := apiClient.RulesApi.GetRule(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "Rule", httpResp) {
+		return
+	}
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Rule", err, httpResp)
+		return
+	}
+	// Log response JSON
+	responseJson, err := apiReadRule.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	readRuleResponse(ctx, apiReadRule, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ruleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateRule(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func updateRule(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from plan
+	var plan ruleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Get the current state to see how any attributes are changing
+	var state ruleResourceModel
+	req.State.Get(ctx, &state)
+	// After an import the state holds the full configuration returned by PingAccess. When it already has every
+	// configured value, only the state needs to change. Otherwise the state holds the previous configuration,
+	// and any field removed from it must be sent to PingAccess to be reset.
+	imported, diags := req.Private.GetKey(ctx, importedKey)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedKey, []byte("false"))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if string(imported) == "true" && plan.Name.Equal(state.Name) && plan.ClassName.Equal(state.ClassName) && configurationAlreadyApplied(state.Configuration, plan.Configuration) {
+		state.Configuration = plan.Configuration
+		state.Timeouts = plan.Timeouts
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return
+	}
+	updateRule := apiClient.RulesApi.UpdateRule(config.AuthContext(ctx, providerConfig), plan.Id.ValueString())
+	createUpdateRequest := client.NewRule(plan.ClassName.ValueString(), plan.Name.ValueString())
+	err := addOptionalRuleFields(ctx, createUpdateRequest, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add optional properties to update request for Rule", err.Error())
+		return
+	}
+	requestJson, err := createUpdateRequest.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Update request: "+string(requestJson))
+	}
+	updateRule = updateRule.Rule(*createUpdateRequest)
+	updateRuleResponse, httpResp, err := apiClient.RulesApi.UpdateRuleExecute(updateRule)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating a Rule", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
+	// Log response JSON
+	responseJson, err := updateRuleResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Update response: "+string(responseJson))
+	}
+	// Read the response
+	readRuleResponse(ctx, updateRuleResponse, &state, &plan, &resp.Diagnostics)
+
+	// Update computed values
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ruleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	deleteRule(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func deleteRule(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from state
+	var state ruleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	httpResp, err := apiClient.RulesApi.DeleteRule(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting a Rule", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
+}
+
+func (r *ruleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve import ID and save to id attribute, looking the ID up first when importing by name
+	config.ImportByIdOrName(ctx, req, resp, "Rule", func(name string) ([]string, *http.Response, error) {
+		var ids []string
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.Rule, *http.Response, error) {
+			apiReadRules, httpResp, err := apiClient.RulesApi.GetRules(config.AuthContext(ctx, providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadRules.GetItems(), httpResp, nil
+		})
+		for i := range items {
+			if items[i].Name == name {
+				ids = append(ids, internaltypes.Int64PointerToString(*items[i].Id))
+			}
+		}
+		return ids, httpResp, err
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Remember that the configuration in state came from PingAccess rather than from the Terraform configuration
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedKey, []byte("true"))...)
+}
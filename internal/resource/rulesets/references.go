@@ -0,0 +1,62 @@
+package rulesets
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Success criteria of Rule Sets and Rule Set Groups
+const (
+	successIfAllSucceed     = "SuccessIfAllSucceed"
+	successIfAnyOneSucceeds = "SuccessIfAnyOneSucceeds"
+)
+
+// Check the success criteria of a Rule Set or Rule Set Group
+func validateSuccessCriteria(successCriteria types.String, diagnostics *diag.Diagnostics) {
+	if !internaltypes.IsDefined(successCriteria) {
+		return
+	}
+	switch successCriteria.ValueString() {
+	case successIfAllSucceed, successIfAnyOneSucceeds:
+	default:
+		diagnostics.AddAttributeError(path.Root("success_criteria"), "Invalid success_criteria",
+			"success_criteria must be either "+successIfAllSucceed+" or "+successIfAnyOneSucceeds)
+	}
+}
+
+// Check that each known id in an ordered list of ids refers to an existing object, using lookup to read the object
+func validateReferencedIds(ctx context.Context, ids types.List, attribute, objectType string, lookup func(id string) (*http.Response, error), diagnostics *diag.Diagnostics) {
+	if !internaltypes.IsDefined(ids) {
+		return
+	}
+	for i, element := range ids.Elements() {
+		id, ok := element.(types.Int64)
+		if !ok || !internaltypes.IsDefined(id) {
+			continue
+		}
+		httpResp, err := lookup(internaltypes.Int64ToString(id))
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			diagnostics.AddAttributeError(path.Root(attribute).AtListIndex(i), "Unknown "+objectType,
+				fmt.Sprintf("No %s with id %d exists", objectType, id.ValueInt64()))
+			continue
+		}
+		if err != nil {
+			config.ReportHttpError(ctx, diagnostics, "An error occurred while looking for a "+objectType, err, httpResp)
+			return
+		}
+	}
+}
+
+// Get the ordered ids sent to PingAccess from a list of ids
+func getIdsRequest(ctx context.Context, ids types.List) []int64 {
+	idsRequest := []int64{}
+	ids.ElementsAs(ctx, &idsRequest, false)
+	return idsRequest
+}
@@ -0,0 +1,359 @@
+package rulesets
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &ruleSetGroupResource{}
+	_ resource.ResourceWithConfigure      = &ruleSetGroupResource{}
+	_ resource.ResourceWithImportState    = &ruleSetGroupResource{}
+	_ resource.ResourceWithValidateConfig = &ruleSetGroupResource{}
+	_ resource.ResourceWithModifyPlan     = &ruleSetGroupResource{}
+)
+
+// RuleSetGroupResource is a helper function to simplify the provider implementation.
+func RuleSetGroupResource() resource.Resource {
+	return &ruleSetGroupResource{}
+}
+
+// ruleSetGroupResource is the resource implementation.
+type ruleSetGroupResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type ruleSetGroupResourceModel struct {
+	Id              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	RuleSetIds      types.List     `tfsdk:"rule_set_ids"`
+	SuccessCriteria types.String   `tfsdk:"success_criteria"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *ruleSetGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ruleSetGroupResourceSchema(ctx, req, resp, false)
+}
+
+func ruleSetGroupResourceSchema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse, setOptionalToComputed bool) {
+	schema := schema.Schema{
+		Description: "Manages a Rule Set Group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"rule_set_ids": schema.ListAttribute{
+				Description: "IDs of the Rule Sets in the Rule Set Group, in the order they are evaluated.",
+				Required:    true,
+				ElementType: types.Int64Type,
+			},
+			"success_criteria": schema.StringAttribute{
+				Description: "Either SuccessIfAllSucceed, when every Rule Set must pass, or SuccessIfAnyOneSucceeds, when the first Rule Set that passes is enough.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+
+	if setOptionalToComputed {
+		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"name", "rule_set_ids"})
+	}
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+	resp.Schema = schema
+}
+
+func addOptionalRuleSetGroupFields(ctx context.Context, addRequest *client.RuleSetGroup, plan ruleSetGroupResourceModel) error {
+	if internaltypes.IsDefined(plan.Id) {
+		addRequest.Id = internaltypes.StringToInt64Pointer(plan.Id)
+	}
+	addRequest.Policy = getIdsRequest(ctx, plan.RuleSetIds)
+	if internaltypes.IsNonEmptyString(plan.SuccessCriteria) {
+		addRequest.SuccessCriteria = plan.SuccessCriteria.ValueStringPointer()
+	}
+	return nil
+}
+
+// Metadata returns the resource type name.
+func (r *ruleSetGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rule_set_group"
+}
+
+func (r *ruleSetGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+
+}
+
+func (r *ruleSetGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model ruleSetGroupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateSuccessCriteria(model.SuccessCriteria, &resp.Diagnostics)
+}
+
+func (r *ruleSetGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate when destroying the resource, or before the provider has been configured
+	if req.Plan.Raw.IsNull() || r.apiClient == nil {
+		return
+	}
+	var plan ruleSetGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateReferencedIds(ctx, plan.RuleSetIds, "rule_set_ids", "Rule Set", func(id string) (*http.Response, error) {
+		_, httpResp, err := r.apiClient.RulesetsApi.GetRuleSet(config.AuthContext(ctx, r.providerConfig), id).Execute()
+		return httpResp, err
+	}, &resp.Diagnostics)
+}
+
+func readRuleSetGroupResponse(ctx context.Context, r *client.RuleSetGroup, state *ruleSetGroupResourceModel, expectedValues *ruleSetGroupResourceModel, diagnostics *diag.Diagnostics) {
+	state.Id = types.StringValue(internaltypes.Int64PointerToString(*r.Id))
+	state.Name = types.StringValue(r.Name)
+	state.RuleSetIds = internaltypes.GetInt64List(r.Policy)
+	state.SuccessCriteria = internaltypes.StringTypeOrNil(r.SuccessCriteria, false)
+}
+
+func (r *ruleSetGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ruleSetGroupResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	createRuleSetGroup := client.NewRuleSetGroup(plan.Name.ValueString())
+	err := addOptionalRuleSetGroupFields(ctx, createRuleSetGroup, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add optional properties to add request for Rule Set Group", err.Error())
+		return
+	}
+	requestJson, err := createRuleSetGroup.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add request: "+string(requestJson))
+	}
+	apiCreateRuleSetGroup := r.apiClient.RuleSetGroupsApi.AddRuleSetGroup(config.AuthContext(ctx, r.providerConfig))
+	apiCreateRuleSetGroup = apiCreateRuleSetGroup.RuleSetGroup(*createRuleSetGroup)
+	ruleSetGroupResponse, httpResp, err := r.apiClient.RuleSetGroupsApi.AddRuleSetGroupExecute(apiCreateRuleSetGroup)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating a Rule Set Group", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
+	responseJson, err := ruleSetGroupResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	var state ruleSetGroupResourceModel
+
+	readRuleSetGroupResponse(ctx, ruleSetGroupResponse, &state, &plan, &resp.Diagnostics)
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ruleSetGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readRuleSetGroup(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func readRuleSetGroup(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	var state ruleSetGroupResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	apiReadRuleSetGroup, httpResp, err := apiClient.RuleSetGroupsApi.GetRuleSetGroup(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "Rule Set Group", httpResp) {
+		return
+	}
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Rule Set Group", err, httpResp)
+		return
+	}
+	// Log response JSON
+	responseJson, err := apiReadRuleSetGroup.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	readRuleSetGroupResponse(ctx, apiReadRuleSetGroup, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ruleSetGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateRuleSetGroup(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func updateRuleSetGroup(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from plan
+	var plan ruleSetGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Get the current state to see how any attributes are changing
+	var state ruleSetGroupResourceModel
+	req.State.Get(ctx, &state)
+	updateRuleSetGroup := apiClient.RuleSetGroupsApi.UpdateRuleSetGroup(config.AuthContext(ctx, providerConfig), plan.Id.ValueString())
+	createUpdateRequest := client.NewRuleSetGroup(plan.Name.ValueString())
+	err := addOptionalRuleSetGroupFields(ctx, createUpdateRequest, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add optional properties to update request for Rule Set Group", err.Error())
+		return
+	}
+	requestJson, err := createUpdateRequest.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Update request: "+string(requestJson))
+	}
+	updateRuleSetGroup = updateRuleSetGroup.RuleSetGroup(*createUpdateRequest)
+	updateRuleSetGroupResponse, httpResp, err := apiClient.RuleSetGroupsApi.UpdateRuleSetGroupExecute(updateRuleSetGroup)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating a Rule Set Group", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
+	// Log response JSON
+	responseJson, err := updateRuleSetGroupResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Update response: "+string(responseJson))
+	}
+	// Read the response
+	readRuleSetGroupResponse(ctx, updateRuleSetGroupResponse, &state, &plan, &resp.Diagnostics)
+
+	// Update computed values
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ruleSetGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	deleteRuleSetGroup(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func deleteRuleSetGroup(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from state
+	var state ruleSetGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	httpResp, err := apiClient.RuleSetGroupsApi.DeleteRuleSetGroup(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting a Rule Set Group", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
+}
+
+func (r *ruleSetGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importRuleSetGroupLocation(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func importRuleSetGroupLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve import ID and save to id attribute, looking the ID up first when importing by name
+	config.ImportByIdOrName(ctx, req, resp, "Rule Set Group", func(name string) ([]string, *http.Response, error) {
+		var ids []string
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.RuleSetGroup, *http.Response, error) {
+			apiReadRuleSetGroups, httpResp, err := apiClient.RuleSetGroupsApi.GetRuleSetGroups(config.AuthContext(ctx, providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadRuleSetGroups.GetItems(), httpResp, nil
+		})
+		for i := range items {
+			if items[i].Name == name {
+				ids = append(ids, internaltypes.Int64PointerToString(*items[i].Id))
+			}
+		}
+		return ids, httpResp, err
+	})
+}
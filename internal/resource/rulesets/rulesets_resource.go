@@ -0,0 +1,387 @@
+package rulesets
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &ruleSetResource{}
+	_ resource.ResourceWithConfigure      = &ruleSetResource{}
+	_ resource.ResourceWithImportState    = &ruleSetResource{}
+	_ resource.ResourceWithValidateConfig = &ruleSetResource{}
+	_ resource.ResourceWithModifyPlan     = &ruleSetResource{}
+)
+
+// Rule Set element types
+const (
+	elementTypeWeb = "Web"
+	elementTypeApi = "API"
+)
+
+// RuleSetResource is a helper function to simplify the provider implementation.
+func RuleSetResource() resource.Resource {
+	return &ruleSetResource{}
+}
+
+// ruleSetResource is the resource implementation.
+type ruleSetResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type ruleSetResourceModel struct {
+	Id              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	RuleIds         types.List     `tfsdk:"rule_ids"`
+	SuccessCriteria types.String   `tfsdk:"success_criteria"`
+	ElementType     types.String   `tfsdk:"element_type"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *ruleSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ruleSetResourceSchema(ctx, req, resp, false)
+}
+
+func ruleSetResourceSchema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse, setOptionalToComputed bool) {
+	schema := schema.Schema{
+		Description: "Manages a Rule Set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"rule_ids": schema.ListAttribute{
+				Description: "IDs of the Rules in the Rule Set, in the order they are evaluated.",
+				Required:    true,
+				ElementType: types.Int64Type,
+			},
+			"success_criteria": schema.StringAttribute{
+				Description: "Either SuccessIfAllSucceed, when every Rule must pass, or SuccessIfAnyOneSucceeds, when the first Rule that passes is enough.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"element_type": schema.StringAttribute{
+				Description: "Type of the Rules in the Rule Set, either Web or API.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+
+	if setOptionalToComputed {
+		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"name", "rule_ids"})
+	}
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+	resp.Schema = schema
+}
+
+func addOptionalRuleSetFields(ctx context.Context, addRequest *client.RuleSet, plan ruleSetResourceModel) error {
+	if internaltypes.IsDefined(plan.Id) {
+		addRequest.Id = internaltypes.StringToInt64Pointer(plan.Id)
+	}
+	addRequest.Policy = getIdsRequest(ctx, plan.RuleIds)
+	if internaltypes.IsNonEmptyString(plan.SuccessCriteria) {
+		addRequest.SuccessCriteria = plan.SuccessCriteria.ValueStringPointer()
+	}
+	if internaltypes.IsNonEmptyString(plan.ElementType) {
+		addRequest.ElementType = plan.ElementType.ValueStringPointer()
+	}
+	return nil
+}
+
+// Metadata returns the resource type name.
+func (r *ruleSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ruleset"
+}
+
+func (r *ruleSetResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+
+}
+
+func (r *ruleSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model ruleSetResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateSuccessCriteria(model.SuccessCriteria, &resp.Diagnostics)
+	if internaltypes.IsDefined(model.ElementType) {
+		switch model.ElementType.ValueString() {
+		case elementTypeWeb, elementTypeApi:
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("element_type"), "Invalid Rule Set element_type",
+				"element_type must be either "+elementTypeWeb+" or "+elementTypeApi)
+		}
+	}
+}
+
+func (r *ruleSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate when destroying the resource, or before the provider has been configured
+	if req.Plan.Raw.IsNull() || r.apiClient == nil {
+		return
+	}
+	var plan ruleSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateReferencedIds(ctx, plan.RuleIds, "rule_ids", "Rule", func(id string) (*http.Response, error) {
+		_, httpResp, err := r.apiClient.RulesApi.GetRule(config.AuthContext(ctx, r.providerConfig), id).Execute()
+		return httpResp, err
+	}, &resp.Diagnostics)
+}
+
+func readRuleSetResponse(ctx context.Context, r *client.RuleSet, state *ruleSetResourceModel, expectedValues *ruleSetResourceModel, diagnostics *diag.Diagnostics) {
+	state.Id = types.StringValue(internaltypes.Int64PointerToString(*r.Id))
+	state.Name = types.StringValue(r.Name)
+	state.RuleIds = internaltypes.GetInt64List(r.Policy)
+	state.SuccessCriteria = internaltypes.StringTypeOrNil(r.SuccessCriteria, false)
+	state.ElementType = internaltypes.StringTypeOrNil(r.ElementType, false)
+}
+
+func (r *ruleSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ruleSetResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	createRuleSet := client.NewRuleSet(plan.Name.ValueString())
+	err := addOptionalRuleSetFields(ctx, createRuleSet, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add optional properties to add request for Rule Set", err.Error())
+		return
+	}
+	requestJson, err := createRuleSet.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add request: "+string(requestJson))
+	}
+	apiCreateRuleSet := r.apiClient.RulesetsApi.AddRuleSet(config.AuthContext(ctx, r.providerConfig))
+	apiCreateRuleSet = apiCreateRuleSet.RuleSet(*createRuleSet)
+	ruleSetResponse, httpResp, err := r.apiClient.RulesetsApi.AddRuleSetExecute(apiCreateRuleSet)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating a Rule Set", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
+	responseJson, err := ruleSetResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	var state ruleSetResourceModel
+
+	readRuleSetResponse(ctx, ruleSetResponse, &state, &plan, &resp.Diagnostics)
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ruleSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readRuleSet(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func readRuleSet(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	var state ruleSetResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	apiReadRuleSet, httpResp, err := apiClient.RulesetsApi.GetRuleSet(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "Rule Set", httpResp) {
+		return
+	}
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Rule Set", err, httpResp)
+		return
+	}
+	// Log response JSON
+	responseJson, err := apiReadRuleSet.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	readRuleSetResponse(ctx, apiReadRuleSet, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ruleSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateRuleSet(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func updateRuleSet(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from plan
+	var plan ruleSetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Get the current state to see how any attributes are changing
+	var state ruleSetResourceModel
+	req.State.Get(ctx, &state)
+	updateRuleSet := apiClient.RulesetsApi.UpdateRuleSet(config.AuthContext(ctx, providerConfig), plan.Id.ValueString())
+	createUpdateRequest := client.NewRuleSet(plan.Name.ValueString())
+	err := addOptionalRuleSetFields(ctx, createUpdateRequest, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add optional properties to update request for Rule Set", err.Error())
+		return
+	}
+	requestJson, err := createUpdateRequest.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Update request: "+string(requestJson))
+	}
+	updateRuleSet = updateRuleSet.RuleSet(*createUpdateRequest)
+	updateRuleSetResponse, httpResp, err := apiClient.RulesetsApi.UpdateRuleSetExecute(updateRuleSet)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating a Rule Set", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
+	// Log response JSON
+	responseJson, err := updateRuleSetResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Update response: "+string(responseJson))
+	}
+	// Read the response
+	readRuleSetResponse(ctx, updateRuleSetResponse, &state, &plan, &resp.Diagnostics)
+
+	// Update computed values
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ruleSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	deleteRuleSet(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func deleteRuleSet(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from state
+	var state ruleSetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	httpResp, err := apiClient.RulesetsApi.DeleteRuleSet(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting a Rule Set", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
+}
+
+func (r *ruleSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importRuleSetLocation(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func importRuleSetLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve import ID and save to id attribute, looking the ID up first when importing by name
+	config.ImportByIdOrName(ctx, req, resp, "Rule Set", func(name string) ([]string, *http.Response, error) {
+		var ids []string
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.RuleSet, *http.Response, error) {
+			apiReadRuleSets, httpResp, err := apiClient.RulesetsApi.GetRuleSets(config.AuthContext(ctx, providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadRuleSets.GetItems(), httpResp, nil
+		})
+		for i := range items {
+			if items[i].Name == name {
+				ids = append(ids, internaltypes.Int64PointerToString(*items[i].Id))
+			}
+		}
+		return ids, httpResp, err
+	})
+}
@@ -0,0 +1,209 @@
+package site
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &siteDataSource{}
+	_ datasource.DataSourceWithConfigure = &siteDataSource{}
+)
+
+// SiteDataSource is a helper function to simplify the provider implementation.
+func SiteDataSource() datasource.DataSource {
+	return &siteDataSource{}
+}
+
+// siteDataSource is the data source implementation.
+type siteDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type siteDataSourceModel struct {
+	Id                        types.String `tfsdk:"id"`
+	AvailabilityProfileId     types.Int64  `tfsdk:"availability_profile_id"`
+	ExpectedHostname          types.String `tfsdk:"expected_hostname"`
+	KeepAliveTimeout          types.Int64  `tfsdk:"keep_alive_timeout"`
+	LoadBalancingStrategyId   types.Int64  `tfsdk:"load_balancing_strategy_id"`
+	MaxConnections            types.Int64  `tfsdk:"max_connections"`
+	MaxWebSocketConnections   types.Int64  `tfsdk:"max_web_socket_connections"`
+	Name                      types.String `tfsdk:"name"`
+	Secure                    types.Bool   `tfsdk:"secure"`
+	SendPaCookie              types.Bool   `tfsdk:"send_pa_cookie"`
+	SiteAuthenticatorIds      types.Set    `tfsdk:"site_authenticator_ids"`
+	SkipHostnameVerification  types.Bool   `tfsdk:"skip_hostname_verification"`
+	Targets                   types.Set    `tfsdk:"targets"`
+	TrustedCertificateGroupId types.Int64  `tfsdk:"trusted_certificate_group_id"`
+	UseProxy                  types.Bool   `tfsdk:"use_proxy"`
+	UseTargetHostHeader       types.Bool   `tfsdk:"use_target_host_header"`
+}
+
+// Schema defines the schema for the data source.
+func (d *siteDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Site by id or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the Site. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Exact name of the Site. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"targets": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"availability_profile_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"expected_hostname": schema.StringAttribute{
+				Computed: true,
+			},
+			"keep_alive_timeout": schema.Int64Attribute{
+				Computed: true,
+			},
+			"load_balancing_strategy_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"max_connections": schema.Int64Attribute{
+				Computed: true,
+			},
+			"max_web_socket_connections": schema.Int64Attribute{
+				Computed: true,
+			},
+			"secure": schema.BoolAttribute{
+				Computed: true,
+			},
+			"send_pa_cookie": schema.BoolAttribute{
+				Computed: true,
+			},
+			"site_authenticator_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
+			"skip_hostname_verification": schema.BoolAttribute{
+				Computed: true,
+			},
+			"trusted_certificate_group_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"use_proxy": schema.BoolAttribute{
+				Computed: true,
+			},
+			"use_target_host_header": schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *siteDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site"
+}
+
+func (d *siteDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	d.providerConfig = providerCfg.ProviderConfig
+	d.apiClient = providerCfg.ApiClient
+}
+
+func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state siteDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.CheckDataSourceLookup(&resp.Diagnostics, state.Id, state.Name, "name") {
+		return
+	}
+
+	var site *client.Site
+	if internaltypes.IsDefined(state.Id) {
+		apiReadSite, httpResp, err := d.apiClient.SitesApi.GetSite(config.AuthContext(ctx, d.providerConfig), state.Id.ValueString()).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Site", err, httpResp)
+			return
+		}
+		site = apiReadSite
+	} else {
+		name := state.Name.ValueString()
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.Site, *http.Response, error) {
+			apiReadSites, httpResp, err := d.apiClient.SitesApi.GetSites(config.AuthContext(ctx, d.providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadSites.GetItems(), httpResp, nil
+		})
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Site", err, httpResp)
+			return
+		}
+		// Only exact matches count
+		var matches []client.Site
+		for _, item := range items {
+			if item.Name == name {
+				matches = append(matches, item)
+			}
+		}
+		if !config.CheckLookupMatches(&resp.Diagnostics, "Site", "name \""+name+"\"", len(matches)) {
+			return
+		}
+		site = &matches[0]
+	}
+	// Log response JSON
+	responseJson, err := site.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	state = readSiteDataSourceResponse(ctx, site)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read the API response into the data source model, reusing the resource mapper
+func readSiteDataSourceResponse(ctx context.Context, r *client.Site) siteDataSourceModel {
+	var model siteResourceModel
+	readSiteResponse(ctx, r, &model, &model)
+	return siteDataSourceModel{
+		Id:                        model.Id,
+		AvailabilityProfileId:     model.AvailabilityProfileId,
+		ExpectedHostname:          model.ExpectedHostname,
+		KeepAliveTimeout:          model.KeepAliveTimeout,
+		LoadBalancingStrategyId:   model.LoadBalancingStrategyId,
+		MaxConnections:            model.MaxConnections,
+		MaxWebSocketConnections:   model.MaxWebSocketConnections,
+		Name:                      model.Name,
+		Secure:                    model.Secure,
+		SendPaCookie:              model.SendPaCookie,
+		SiteAuthenticatorIds:      model.SiteAuthenticatorIds,
+		SkipHostnameVerification:  model.SkipHostnameVerification,
+		Targets:                   model.Targets,
+		TrustedCertificateGroupId: model.TrustedCertificateGroupId,
+		UseProxy:                  model.UseProxy,
+		UseTargetHostHeader:       model.UseTargetHostHeader,
+	}
+}
@@ -0,0 +1,173 @@
+package site
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &sitesDataSource{}
+	_ datasource.DataSourceWithConfigure = &sitesDataSource{}
+)
+
+// SitesDataSource is a helper function to simplify the provider implementation.
+func SitesDataSource() datasource.DataSource {
+	return &sitesDataSource{}
+}
+
+// sitesDataSource is the data source implementation.
+type sitesDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type sitesDataSourceModel struct {
+	Id      types.String          `tfsdk:"id"`
+	Filter  types.String          `tfsdk:"filter"`
+	Name    types.String          `tfsdk:"name"`
+	SortKey types.String          `tfsdk:"sort_key"`
+	Order   types.String          `tfsdk:"order"`
+	Items   []siteDataSourceModel `tfsdk:"items"`
+}
+
+// Schema defines the schema for the data source.
+func (d *sitesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Lists the Sites matching the given filters.",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Description: "The matching Sites.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"targets": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"availability_profile_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"expected_hostname": schema.StringAttribute{
+							Computed: true,
+						},
+						"keep_alive_timeout": schema.Int64Attribute{
+							Computed: true,
+						},
+						"load_balancing_strategy_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"max_connections": schema.Int64Attribute{
+							Computed: true,
+						},
+						"max_web_socket_connections": schema.Int64Attribute{
+							Computed: true,
+						},
+						"secure": schema.BoolAttribute{
+							Computed: true,
+						},
+						"send_pa_cookie": schema.BoolAttribute{
+							Computed: true,
+						},
+						"site_authenticator_ids": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.Int64Type,
+						},
+						"skip_hostname_verification": schema.BoolAttribute{
+							Computed: true,
+						},
+						"trusted_certificate_group_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"use_proxy": schema.BoolAttribute{
+							Computed: true,
+						},
+						"use_target_host_header": schema.BoolAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+	config.AddListDataSourceSchema(&schema, "name")
+	resp.Schema = schema
+}
+
+// Metadata returns the data source type name.
+func (d *sitesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sites"
+}
+
+func (d *sitesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	d.providerConfig = providerCfg.ProviderConfig
+	d.apiClient = providerCfg.ApiClient
+}
+
+func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state sitesDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.CheckListOrder(&resp.Diagnostics, state.Order) {
+		return
+	}
+
+	state.Items = []siteDataSourceModel{}
+	items, httpResp, err := config.ListAllPages(func(page int32) ([]client.Site, *http.Response, error) {
+		apiListSites := d.apiClient.SitesApi.GetSites(config.AuthContext(ctx, d.providerConfig)).Page(page).NumberPerPage(config.ListPageSize)
+		if internaltypes.IsDefined(state.Filter) {
+			apiListSites = apiListSites.Filter(state.Filter.ValueString())
+		}
+		if internaltypes.IsDefined(state.Name) {
+			apiListSites = apiListSites.Name(state.Name.ValueString())
+		}
+		if internaltypes.IsDefined(state.SortKey) {
+			apiListSites = apiListSites.SortKey(state.SortKey.ValueString())
+		}
+		if internaltypes.IsDefined(state.Order) {
+			apiListSites = apiListSites.Order(state.Order.ValueString())
+		}
+		apiReadSites, httpResp, err := apiListSites.Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		return apiReadSites.GetItems(), httpResp, nil
+	})
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while listing Sites", err, httpResp)
+		return
+	}
+	for i := range items {
+		// The name query parameter isn't an exact match
+		if internaltypes.IsDefined(state.Name) && items[i].Name != state.Name.ValueString() {
+			continue
+		}
+		state.Items = append(state.Items, readSiteDataSourceResponse(ctx, &items[i]))
+	}
+
+	state.Id = types.StringValue("sites")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -2,8 +2,9 @@ package site
 
 import (
 	"context"
+	"net/http"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -37,22 +38,23 @@ type siteResource struct {
 }
 
 type siteResourceModel struct {
-	Id                        types.String `tfsdk:"id"`
-	AvailabilityProfileId     types.Int64  `tfsdk:"availability_profile_id"`
-	ExpectedHostname          types.String `tfsdk:"expected_hostname"`
-	KeepAliveTimeout          types.Int64  `tfsdk:"keep_alive_timeout"`
-	LoadBalancingStrategyId   types.Int64  `tfsdk:"load_balancing_strategy_id"`
-	MaxConnections            types.Int64  `tfsdk:"max_connections"`
-	MaxWebSocketConnections   types.Int64  `tfsdk:"max_web_socket_connections"`
-	Name                      types.String `tfsdk:"name"`
-	Secure                    types.Bool   `tfsdk:"secure"`
-	SendPaCookie              types.Bool   `tfsdk:"send_pa_cookie"`
-	SiteAuthenticatorIds      types.Set    `tfsdk:"site_authenticator_ids"`
-	SkipHostnameVerification  types.Bool   `tfsdk:"skip_hostname_verification"`
-	Targets                   types.Set    `tfsdk:"targets"`
-	TrustedCertificateGroupId types.Int64  `tfsdk:"trusted_certificate_group_id"`
-	UseProxy                  types.Bool   `tfsdk:"use_proxy"`
-	UseTargetHostHeader       types.Bool   `tfsdk:"use_target_host_header"`
+	Id                        types.String   `tfsdk:"id"`
+	AvailabilityProfileId     types.Int64    `tfsdk:"availability_profile_id"`
+	ExpectedHostname          types.String   `tfsdk:"expected_hostname"`
+	KeepAliveTimeout          types.Int64    `tfsdk:"keep_alive_timeout"`
+	LoadBalancingStrategyId   types.Int64    `tfsdk:"load_balancing_strategy_id"`
+	MaxConnections            types.Int64    `tfsdk:"max_connections"`
+	MaxWebSocketConnections   types.Int64    `tfsdk:"max_web_socket_connections"`
+	Name                      types.String   `tfsdk:"name"`
+	Secure                    types.Bool     `tfsdk:"secure"`
+	SendPaCookie              types.Bool     `tfsdk:"send_pa_cookie"`
+	SiteAuthenticatorIds      types.Set      `tfsdk:"site_authenticator_ids"`
+	SkipHostnameVerification  types.Bool     `tfsdk:"skip_hostname_verification"`
+	Targets                   types.Set      `tfsdk:"targets"`
+	TrustedCertificateGroupId types.Int64    `tfsdk:"trusted_certificate_group_id"`
+	UseProxy                  types.Bool     `tfsdk:"use_proxy"`
+	UseTargetHostHeader       types.Bool     `tfsdk:"use_target_host_header"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -177,6 +179,12 @@ func siteResourceSchema(ctx context.Context, req resource.SchemaRequest, resp *r
 	if setOptionalToComputed {
 		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"name", "targets"})
 	}
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
 	resp.Schema = schema
 }
 func addOptionalSiteFields(ctx context.Context, addRequest *client.Site, plan siteResourceModel) error {
@@ -283,6 +291,13 @@ func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 	var TargetsSlice []string
 	plan.Targets.ElementsAs(ctx, &TargetsSlice, false)
 	createSite := client.NewSite(plan.Name.ValueString(), TargetsSlice)
@@ -296,13 +311,14 @@ func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, r
 		tflog.Debug(ctx, "Add request: "+string(requestJson))
 	}
 
-	apiCreateSite := r.apiClient.SitesApi.AddSite(config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiCreateSite := r.apiClient.SitesApi.AddSite(config.AuthContext(ctx, r.providerConfig))
 	apiCreateSite = apiCreateSite.Site(*createSite)
 	siteResponse, httpResp, err := r.apiClient.SitesApi.AddSiteExecute(apiCreateSite)
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Site", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
 	responseJson, err := siteResponse.MarshalJSON()
 	if err == nil {
 		tflog.Debug(ctx, "Add response: "+string(responseJson))
@@ -312,6 +328,7 @@ func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, r
 	var state siteResourceModel
 
 	readSiteResponse(ctx, siteResponse, &state, &plan)
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -331,8 +348,17 @@ func readSite(ctx context.Context, req resource.ReadRequest, resp *resource.Read
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	apiReadSite, httpResp, err := apiClient.SitesApi.GetSite(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
-
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	apiReadSite, httpResp, err := apiClient.SitesApi.GetSite(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "Site", httpResp) {
+		return
+	}
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Site", err, httpResp)
 		return
@@ -368,13 +394,20 @@ func updateSite(ctx context.Context, req resource.UpdateRequest, resp *resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Get the current state to see how any attributes are changing
 	var state siteResourceModel
 	req.State.Get(ctx, &state)
 	var TargetsSlice []string
 	plan.Targets.ElementsAs(ctx, &TargetsSlice, false)
-	UpdateSite := apiClient.SitesApi.UpdateSite(config.ProviderBasicAuthContext(ctx, providerConfig), plan.Id.ValueString())
+	UpdateSite := apiClient.SitesApi.UpdateSite(config.AuthContext(ctx, providerConfig), plan.Id.ValueString())
 	CreateUpdateRequest := client.NewSite(plan.Name.ValueString(), TargetsSlice)
 	err := addOptionalSiteFields(ctx, CreateUpdateRequest, plan)
 	if err != nil {
@@ -391,6 +424,7 @@ func updateSite(ctx context.Context, req resource.UpdateRequest, resp *resource.
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating Site", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 	// Log response JSON
 	responseJson, err := updateSiteResponse.MarshalJSON()
 	if err == nil {
@@ -400,6 +434,7 @@ func updateSite(ctx context.Context, req resource.UpdateRequest, resp *resource.
 	readSiteResponse(ctx, updateSiteResponse, &state, &plan)
 
 	// Update computed values
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -420,18 +455,41 @@ func deleteSite(ctx context.Context, req resource.DeleteRequest, resp *resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	httpResp, err := apiClient.SitesApi.DeleteSite(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	httpResp, err := apiClient.SitesApi.DeleteSite(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting a Site", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 
 }
 
 func (r *siteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	importLocation(ctx, req, resp)
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
 }
-func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve import ID and save to id attribute, looking the ID up first when importing by name
+	config.ImportByIdOrName(ctx, req, resp, "Site", func(name string) ([]string, *http.Response, error) {
+		var ids []string
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.Site, *http.Response, error) {
+			apiReadSites, httpResp, err := apiClient.SitesApi.GetSites(config.AuthContext(ctx, providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadSites.GetItems(), httpResp, nil
+		})
+		for i := range items {
+			if items[i].Name == name {
+				ids = append(ids, internaltypes.Int64PointerToString(*items[i].Id))
+			}
+		}
+		return ids, httpResp, err
+	})
 }
@@ -0,0 +1,184 @@
+package thirdPartyService
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &thirdPartyServiceDataSource{}
+	_ datasource.DataSourceWithConfigure = &thirdPartyServiceDataSource{}
+)
+
+// ThirdPartyServiceDataSource is a helper function to simplify the provider implementation.
+func ThirdPartyServiceDataSource() datasource.DataSource {
+	return &thirdPartyServiceDataSource{}
+}
+
+// thirdPartyServiceDataSource is the data source implementation.
+type thirdPartyServiceDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type thirdPartyServiceDataSourceModel struct {
+	Id                        types.String `tfsdk:"id"`
+	Name                      types.String `tfsdk:"name"`
+	AvailabilityProfileId     types.Int64  `tfsdk:"availability_profile_id"`
+	ExpectedHostname          types.String `tfsdk:"expected_hostname"`
+	HostValue                 types.String `tfsdk:"host_value"`
+	LoadBalancingStrategyId   types.Int64  `tfsdk:"load_balancing_strategy_id"`
+	MaxConnections            types.Int64  `tfsdk:"max_connections"`
+	Secure                    types.Bool   `tfsdk:"secure"`
+	SkipHostnameVerification  types.Bool   `tfsdk:"skip_hostname_verification"`
+	Targets                   types.Set    `tfsdk:"targets"`
+	TrustedCertificateGroupId types.Int64  `tfsdk:"trusted_certificate_group_id"`
+	UseProxy                  types.Bool   `tfsdk:"use_proxy"`
+}
+
+// Schema defines the schema for the data source.
+func (d *thirdPartyServiceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Third-Party Service by id or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the Third-Party Service. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Exact name of the Third-Party Service. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"availability_profile_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"expected_hostname": schema.StringAttribute{
+				Computed: true,
+			},
+			"host_value": schema.StringAttribute{
+				Computed: true,
+			},
+			"load_balancing_strategy_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"max_connections": schema.Int64Attribute{
+				Computed: true,
+			},
+			"secure": schema.BoolAttribute{
+				Computed: true,
+			},
+			"skip_hostname_verification": schema.BoolAttribute{
+				Computed: true,
+			},
+			"targets": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"trusted_certificate_group_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"use_proxy": schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *thirdPartyServiceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_third_party_service"
+}
+
+func (d *thirdPartyServiceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	d.providerConfig = providerCfg.ProviderConfig
+	d.apiClient = providerCfg.ApiClient
+}
+
+func (d *thirdPartyServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state thirdPartyServiceDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.CheckDataSourceLookup(&resp.Diagnostics, state.Id, state.Name, "name") {
+		return
+	}
+
+	var thirdPartyService *client.ThirdPartyService
+	if internaltypes.IsDefined(state.Id) {
+		apiReadThirdPartyService, httpResp, err := d.apiClient.ThirdPartyServicesApi.GetThirdPartyService(config.AuthContext(ctx, d.providerConfig), state.Id.ValueString()).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Third-Party Service", err, httpResp)
+			return
+		}
+		thirdPartyService = apiReadThirdPartyService
+	} else {
+		name := state.Name.ValueString()
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.ThirdPartyService, *http.Response, error) {
+			apiReadThirdPartyServices, httpResp, err := d.apiClient.ThirdPartyServicesApi.GetThirdPartyServices(config.AuthContext(ctx, d.providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadThirdPartyServices.GetItems(), httpResp, nil
+		})
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Third-Party Service", err, httpResp)
+			return
+		}
+		// Only exact matches count
+		var matches []client.ThirdPartyService
+		for _, item := range items {
+			if item.Name == name {
+				matches = append(matches, item)
+			}
+		}
+		if !config.CheckLookupMatches(&resp.Diagnostics, "Third-Party Service", "name \""+name+"\"", len(matches)) {
+			return
+		}
+		thirdPartyService = &matches[0]
+	}
+	// Log response JSON
+	responseJson, err := thirdPartyService.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response using the resource mapper
+	var model thirdPartyServiceResourceModel
+	readThirdPartyServiceResponse(ctx, thirdPartyService, &model, &model)
+	state = thirdPartyServiceDataSourceModel{
+		Id:                        model.Id,
+		Name:                      model.Name,
+		AvailabilityProfileId:     model.AvailabilityProfileId,
+		ExpectedHostname:          model.ExpectedHostname,
+		HostValue:                 model.HostValue,
+		LoadBalancingStrategyId:   model.LoadBalancingStrategyId,
+		MaxConnections:            model.MaxConnections,
+		Secure:                    model.Secure,
+		SkipHostnameVerification:  model.SkipHostnameVerification,
+		Targets:                   model.Targets,
+		TrustedCertificateGroupId: model.TrustedCertificateGroupId,
+		UseProxy:                  model.UseProxy,
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -2,8 +2,9 @@ package thirdPartyService
 
 import (
 	"context"
+	"net/http"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -36,18 +37,19 @@ type thirdPartyServiceResource struct {
 }
 
 type thirdPartyServiceResourceModel struct {
-	Id                        types.String `tfsdk:"id"`
-	AvailabilityProfileId     types.Int64  `tfsdk:"availability_profile_id"`
-	ExpectedHostname          types.String `tfsdk:"expected_hostname"`
-	HostValue                 types.String `tfsdk:"host_value"`
-	LoadBalancingStrategyId   types.Int64  `tfsdk:"load_balancing_strategy_id"`
-	MaxConnections            types.Int64  `tfsdk:"max_connections"`
-	Name                      types.String `tfsdk:"name"`
-	Secure                    types.Bool   `tfsdk:"secure"`
-	SkipHostnameVerification  types.Bool   `tfsdk:"skip_hostname_verification"`
-	Targets                   types.Set    `tfsdk:"targets"`
-	TrustedCertificateGroupId types.Int64  `tfsdk:"trusted_certificate_group_id"`
-	UseProxy                  types.Bool   `tfsdk:"use_proxy"`
+	Id                        types.String   `tfsdk:"id"`
+	AvailabilityProfileId     types.Int64    `tfsdk:"availability_profile_id"`
+	ExpectedHostname          types.String   `tfsdk:"expected_hostname"`
+	HostValue                 types.String   `tfsdk:"host_value"`
+	LoadBalancingStrategyId   types.Int64    `tfsdk:"load_balancing_strategy_id"`
+	MaxConnections            types.Int64    `tfsdk:"max_connections"`
+	Name                      types.String   `tfsdk:"name"`
+	Secure                    types.Bool     `tfsdk:"secure"`
+	SkipHostnameVerification  types.Bool     `tfsdk:"skip_hostname_verification"`
+	Targets                   types.Set      `tfsdk:"targets"`
+	TrustedCertificateGroupId types.Int64    `tfsdk:"trusted_certificate_group_id"`
+	UseProxy                  types.Bool     `tfsdk:"use_proxy"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -140,6 +142,12 @@ func thirdPartyServiceResourceSchema(ctx context.Context, req resource.SchemaReq
 	if setOptionalToComputed {
 		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"name", "targets"})
 	}
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
 	resp.Schema = schema
 }
 func addOptionalThirdPartyServiceFields(ctx context.Context, addRequest *client.ThirdPartyService, plan thirdPartyServiceResourceModel) error {
@@ -225,6 +233,13 @@ func (r *thirdPartyServiceResource) Create(ctx context.Context, req resource.Cre
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 	var TargetsSlice []string
 	plan.Targets.ElementsAs(ctx, &TargetsSlice, false)
 	createThirdPartyService := client.NewThirdPartyService(TargetsSlice, plan.Name.ValueString())
@@ -238,13 +253,14 @@ func (r *thirdPartyServiceResource) Create(ctx context.Context, req resource.Cre
 		tflog.Debug(ctx, "Add request: "+string(requestJson))
 	}
 
-	apiCreateThirdPartyService := r.apiClient.ThirdPartyServicesApi.AddThirdPartyService(config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiCreateThirdPartyService := r.apiClient.ThirdPartyServicesApi.AddThirdPartyService(config.AuthContext(ctx, r.providerConfig))
 	apiCreateThirdPartyService = apiCreateThirdPartyService.ThirdPartyService(*createThirdPartyService)
 	thirdPartyServiceResponse, httpResp, err := r.apiClient.ThirdPartyServicesApi.AddThirdPartyServiceExecute(apiCreateThirdPartyService)
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the ThirdPartyService", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
 	responseJson, err := thirdPartyServiceResponse.MarshalJSON()
 	if err == nil {
 		tflog.Debug(ctx, "Add response: "+string(responseJson))
@@ -254,6 +270,7 @@ func (r *thirdPartyServiceResource) Create(ctx context.Context, req resource.Cre
 	var state thirdPartyServiceResourceModel
 
 	readThirdPartyServiceResponse(ctx, thirdPartyServiceResponse, &state, &plan)
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -273,8 +290,17 @@ func readThirdPartyService(ctx context.Context, req resource.ReadRequest, resp *
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	apiReadThirdPartyService, httpResp, err := apiClient.ThirdPartyServicesApi.GetThirdPartyService(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
-
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	apiReadThirdPartyService, httpResp, err := apiClient.ThirdPartyServicesApi.GetThirdPartyService(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "Third Party Service", httpResp) {
+		return
+	}
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a ThirdPartyService", err, httpResp)
 		return
@@ -310,13 +336,20 @@ func updateThirdPartyService(ctx context.Context, req resource.UpdateRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Get the current state to see how any attributes are changing
 	var state thirdPartyServiceResourceModel
 	req.State.Get(ctx, &state)
 	var TargetsSlice []string
 	plan.Targets.ElementsAs(ctx, &TargetsSlice, false)
-	UpdateThirdPartyService := apiClient.ThirdPartyServicesApi.UpdateThirdPartyService(config.ProviderBasicAuthContext(ctx, providerConfig), plan.Id.ValueString())
+	UpdateThirdPartyService := apiClient.ThirdPartyServicesApi.UpdateThirdPartyService(config.AuthContext(ctx, providerConfig), plan.Id.ValueString())
 	CreateUpdateRequest := client.NewThirdPartyService(TargetsSlice, plan.Name.ValueString())
 	err := addOptionalThirdPartyServiceFields(ctx, CreateUpdateRequest, plan)
 	if err != nil {
@@ -333,6 +366,7 @@ func updateThirdPartyService(ctx context.Context, req resource.UpdateRequest, re
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating ThirdPartyService", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 	// Log response JSON
 	responseJson, err := updateThirdPartyServiceResponse.MarshalJSON()
 	if err == nil {
@@ -342,6 +376,7 @@ func updateThirdPartyService(ctx context.Context, req resource.UpdateRequest, re
 	readThirdPartyServiceResponse(ctx, updateThirdPartyServiceResponse, &state, &plan)
 
 	// Update computed values
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -362,18 +397,41 @@ func deleteThirdPartyService(ctx context.Context, req resource.DeleteRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	httpResp, err := apiClient.ThirdPartyServicesApi.DeleteThirdPartyService(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	httpResp, err := apiClient.ThirdPartyServicesApi.DeleteThirdPartyService(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting a ThirdPartyService", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 
 }
 
 func (r *thirdPartyServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	importLocation(ctx, req, resp)
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
 }
-func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve import ID and save to id attribute, looking the ID up first when importing by name
+	config.ImportByIdOrName(ctx, req, resp, "Third Party Service", func(name string) ([]string, *http.Response, error) {
+		var ids []string
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.ThirdPartyService, *http.Response, error) {
+			apiReadThirdPartyServices, httpResp, err := apiClient.ThirdPartyServicesApi.GetThirdPartyServices(config.AuthContext(ctx, providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadThirdPartyServices.GetItems(), httpResp, nil
+		})
+		for i := range items {
+			if items[i].Name == name {
+				ids = append(ids, *items[i].Id)
+			}
+		}
+		return ids, httpResp, err
+	})
 }
@@ -0,0 +1,181 @@
+package trustedCertificateGroup
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &trustedCertificateGroupDataSource{}
+	_ datasource.DataSourceWithConfigure = &trustedCertificateGroupDataSource{}
+)
+
+// TrustedCertificateGroupDataSource is a helper function to simplify the provider implementation.
+func TrustedCertificateGroupDataSource() datasource.DataSource {
+	return &trustedCertificateGroupDataSource{}
+}
+
+// trustedCertificateGroupDataSource is the data source implementation.
+type trustedCertificateGroupDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type trustedCertificateGroupDataSourceModel struct {
+	Id                         types.String `tfsdk:"id"`
+	Name                       types.String `tfsdk:"name"`
+	CertIds                    types.Set    `tfsdk:"cert_ids"`
+	IgnoreAllCertificateErrors types.Bool   `tfsdk:"ignore_all_certificate_errors"`
+	RevocationChecking         types.Object `tfsdk:"revocation_checking"`
+	SkipCertificateDateCheck   types.Bool   `tfsdk:"skip_certificate_date_check"`
+	SystemGroup                types.Bool   `tfsdk:"system_group"`
+	UseJavaTrustStore          types.Bool   `tfsdk:"use_java_trust_store"`
+}
+
+// Schema defines the schema for the data source.
+func (d *trustedCertificateGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Trusted Certificate Group by id or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the Trusted Certificate Group. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Exact name of the Trusted Certificate Group. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"cert_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
+			"ignore_all_certificate_errors": schema.BoolAttribute{
+				Computed: true,
+			},
+			"revocation_checking": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"crl_checking": schema.BoolAttribute{
+						Computed: true,
+					},
+					"ocsp": schema.BoolAttribute{
+						Computed: true,
+					},
+					"deny_revocation_status_unknown": schema.BoolAttribute{
+						Computed: true,
+					},
+					"support_disordered_chain": schema.BoolAttribute{
+						Computed: true,
+					},
+					"skip_trust_anchors": schema.BoolAttribute{
+						Computed: true,
+					},
+				},
+			},
+			"skip_certificate_date_check": schema.BoolAttribute{
+				Computed: true,
+			},
+			"system_group": schema.BoolAttribute{
+				Computed: true,
+			},
+			"use_java_trust_store": schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *trustedCertificateGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trusted_certificate_group"
+}
+
+func (d *trustedCertificateGroupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	d.providerConfig = providerCfg.ProviderConfig
+	d.apiClient = providerCfg.ApiClient
+}
+
+func (d *trustedCertificateGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state trustedCertificateGroupDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.CheckDataSourceLookup(&resp.Diagnostics, state.Id, state.Name, "name") {
+		return
+	}
+
+	var trustedCertificateGroup *client.TrustedCertificateGroup
+	if internaltypes.IsDefined(state.Id) {
+		apiReadTrustedCertificateGroup, httpResp, err := d.apiClient.TrustedCertificateGroupsApi.GetTrustedCertificateGroup(config.AuthContext(ctx, d.providerConfig), state.Id.ValueString()).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Trusted Certificate Group", err, httpResp)
+			return
+		}
+		trustedCertificateGroup = apiReadTrustedCertificateGroup
+	} else {
+		name := state.Name.ValueString()
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.TrustedCertificateGroup, *http.Response, error) {
+			apiReadTrustedCertificateGroups, httpResp, err := d.apiClient.TrustedCertificateGroupsApi.GetTrustedCertificateGroups(config.AuthContext(ctx, d.providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadTrustedCertificateGroups.GetItems(), httpResp, nil
+		})
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Trusted Certificate Group", err, httpResp)
+			return
+		}
+		// Only exact matches count
+		var matches []client.TrustedCertificateGroup
+		for _, item := range items {
+			if item.Name == name {
+				matches = append(matches, item)
+			}
+		}
+		if !config.CheckLookupMatches(&resp.Diagnostics, "Trusted Certificate Group", "name \""+name+"\"", len(matches)) {
+			return
+		}
+		trustedCertificateGroup = &matches[0]
+	}
+	// Log response JSON
+	responseJson, err := trustedCertificateGroup.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response using the resource mapper
+	var model trustedCertificateGroupResourceModel
+	readTrustedCertificateGroupResponse(ctx, trustedCertificateGroup, &model, &model, &resp.Diagnostics)
+	state = trustedCertificateGroupDataSourceModel{
+		Id:                         model.Id,
+		Name:                       model.Name,
+		CertIds:                    model.CertIds,
+		IgnoreAllCertificateErrors: model.IgnoreAllCertificateErrors,
+		RevocationChecking:         model.RevocationChecking,
+		SkipCertificateDateCheck:   model.SkipCertificateDateCheck,
+		SystemGroup:                model.SystemGroup,
+		UseJavaTrustStore:          model.UseJavaTrustStore,
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -2,10 +2,11 @@ package trustedCertificateGroup
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -40,14 +41,15 @@ type trustedCertificateGroupResource struct {
 }
 
 type trustedCertificateGroupResourceModel struct {
-	Id                         types.String `tfsdk:"id"`
-	CertIds                    types.Set    `tfsdk:"cert_ids"`
-	IgnoreAllCertificateErrors types.Bool   `tfsdk:"ignore_all_certificate_errors"`
-	Name                       types.String `tfsdk:"name"`
-	RevocationChecking         types.Object `tfsdk:"revocation_checking"`
-	SkipCertificateDateCheck   types.Bool   `tfsdk:"skip_certificate_date_check"`
-	SystemGroup                types.Bool   `tfsdk:"system_group"`
-	UseJavaTrustStore          types.Bool   `tfsdk:"use_java_trust_store"`
+	Id                         types.String   `tfsdk:"id"`
+	CertIds                    types.Set      `tfsdk:"cert_ids"`
+	IgnoreAllCertificateErrors types.Bool     `tfsdk:"ignore_all_certificate_errors"`
+	Name                       types.String   `tfsdk:"name"`
+	RevocationChecking         types.Object   `tfsdk:"revocation_checking"`
+	SkipCertificateDateCheck   types.Bool     `tfsdk:"skip_certificate_date_check"`
+	SystemGroup                types.Bool     `tfsdk:"system_group"`
+	UseJavaTrustStore          types.Bool     `tfsdk:"use_java_trust_store"`
+	Timeouts                   timeouts.Value `tfsdk:"timeouts"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -159,6 +161,12 @@ func trustedCertificateGroupResourceSchema(ctx context.Context, req resource.Sch
 	if setOptionalToComputed {
 		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"name"})
 	}
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
 	resp.Schema = schema
 }
 func addOptionalTrustedCertificateGroupFields(ctx context.Context, addRequest *client.TrustedCertificateGroup, plan trustedCertificateGroupResourceModel) error {
@@ -256,6 +264,13 @@ func (r *trustedCertificateGroupResource) Create(ctx context.Context, req resour
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	createTrustedCertificateGroup := client.NewTrustedCertificateGroup(plan.Name.ValueString())
 	err := addOptionalTrustedCertificateGroupFields(ctx, createTrustedCertificateGroup, plan)
@@ -268,13 +283,14 @@ func (r *trustedCertificateGroupResource) Create(ctx context.Context, req resour
 		tflog.Debug(ctx, "Add request: "+string(requestJson))
 	}
 
-	apiCreateTrustedCertificateGroup := r.apiClient.TrustedCertificateGroupsApi.AddTrustedCertificateGroup(config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiCreateTrustedCertificateGroup := r.apiClient.TrustedCertificateGroupsApi.AddTrustedCertificateGroup(config.AuthContext(ctx, r.providerConfig))
 	apiCreateTrustedCertificateGroup = apiCreateTrustedCertificateGroup.TrustedCertificateGroup(*createTrustedCertificateGroup)
 	trustedCertificateGroupResponse, httpResp, err := r.apiClient.TrustedCertificateGroupsApi.AddTrustedCertificateGroupExecute(apiCreateTrustedCertificateGroup)
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the TrustedCertificateGroup", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
 	responseJson, err := trustedCertificateGroupResponse.MarshalJSON()
 	if err == nil {
 		tflog.Debug(ctx, "Add response: "+string(responseJson))
@@ -284,6 +300,7 @@ func (r *trustedCertificateGroupResource) Create(ctx context.Context, req resour
 	var state trustedCertificateGroupResourceModel
 
 	readTrustedCertificateGroupResponse(ctx, trustedCertificateGroupResponse, &state, &plan, &diags)
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -303,8 +320,17 @@ func readTrustedCertificateGroup(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	apiReadTrustedCertificateGroup, httpResp, err := apiClient.TrustedCertificateGroupsApi.GetTrustedCertificateGroup(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
-
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	apiReadTrustedCertificateGroup, httpResp, err := apiClient.TrustedCertificateGroupsApi.GetTrustedCertificateGroup(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "Trusted Certificate Group", httpResp) {
+		return
+	}
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a TrustedCertificateGroup", err, httpResp)
 		return
@@ -340,11 +366,18 @@ func updateTrustedCertificateGroup(ctx context.Context, req resource.UpdateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Get the current state to see how any attributes are changing
 	var state trustedCertificateGroupResourceModel
 	req.State.Get(ctx, &state)
-	UpdateTrustedCertificateGroup := apiClient.TrustedCertificateGroupsApi.UpdateTrustedCertificateGroup(config.ProviderBasicAuthContext(ctx, providerConfig), plan.Id.ValueString())
+	UpdateTrustedCertificateGroup := apiClient.TrustedCertificateGroupsApi.UpdateTrustedCertificateGroup(config.AuthContext(ctx, providerConfig), plan.Id.ValueString())
 	CreateUpdateRequest := client.NewTrustedCertificateGroup(plan.Name.ValueString())
 	err := addOptionalTrustedCertificateGroupFields(ctx, CreateUpdateRequest, plan)
 	if err != nil {
@@ -361,6 +394,7 @@ func updateTrustedCertificateGroup(ctx context.Context, req resource.UpdateReque
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating TrustedCertificateGroup", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 	// Log response JSON
 	responseJson, err := updateTrustedCertificateGroupResponse.MarshalJSON()
 	if err == nil {
@@ -370,6 +404,7 @@ func updateTrustedCertificateGroup(ctx context.Context, req resource.UpdateReque
 	readTrustedCertificateGroupResponse(ctx, updateTrustedCertificateGroupResponse, &state, &plan, &diags)
 
 	// Update computed values
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -390,18 +425,41 @@ func deleteTrustedCertificateGroup(ctx context.Context, req resource.DeleteReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	httpResp, err := apiClient.TrustedCertificateGroupsApi.DeleteTrustedCertificateGroup(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	httpResp, err := apiClient.TrustedCertificateGroupsApi.DeleteTrustedCertificateGroup(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting a TrustedCertificateGroup", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 
 }
 
 func (r *trustedCertificateGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	importLocation(ctx, req, resp)
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
 }
-func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve import ID and save to id attribute, looking the ID up first when importing by name
+	config.ImportByIdOrName(ctx, req, resp, "Trusted Certificate Group", func(name string) ([]string, *http.Response, error) {
+		var ids []string
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.TrustedCertificateGroup, *http.Response, error) {
+			apiReadTrustedCertificateGroups, httpResp, err := apiClient.TrustedCertificateGroupsApi.GetTrustedCertificateGroups(config.AuthContext(ctx, providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadTrustedCertificateGroups.GetItems(), httpResp, nil
+		})
+		for i := range items {
+			if items[i].Name == name {
+				ids = append(ids, internaltypes.Int64PointerToString(*items[i].Id))
+			}
+		}
+		return ids, httpResp, err
+	})
 }
@@ -3,10 +3,19 @@ package config
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	client "github.com/pingidentity/pingaccess-go-client"
 	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
@@ -22,14 +31,244 @@ func BasicAuthContext(ctx context.Context, username, password string) context.Co
 
 // Get a BasicAuth context from a ProviderConfiguration
 func ProviderBasicAuthContext(ctx context.Context, providerConfig internaltypes.ProviderConfiguration) context.Context {
+	// No basic auth is sent when the provider authenticates with a client certificate only
+	if providerConfig.Username == "" && providerConfig.Password == "" {
+		return ctx
+	}
 	return BasicAuthContext(ctx, providerConfig.Username, providerConfig.Password)
 }
 
+// Get the authentication context for an admin API request from a ProviderConfiguration.
+// Resources should use this rather than building a BasicAuth context directly. When OAuth is
+// configured, bearer tokens are added by the provider's HTTP transport, so no credentials are
+// needed on the context.
+func AuthContext(ctx context.Context, providerConfig internaltypes.ProviderConfiguration) context.Context {
+	if providerConfig.OAuthAccessToken != "" || providerConfig.OAuthClientId != "" {
+		return ctx
+	}
+	return ProviderBasicAuthContext(ctx, providerConfig)
+}
+
+// How often to poll engine and replica admin status while waiting for replication
+const replicationPollInterval = 2 * time.Second
+
+// Wait for every engine and replica admin to pick up a configuration change, when wait_for_replication is
+// enabled. writeResp is the response to the add, update or delete request that made the change. Nodes
+// that haven't caught up before the replication timeout are reported as a warning, since the
+// change itself has already been applied on the admin node.
+func WaitForReplication(ctx context.Context, diagnostics *diag.Diagnostics, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, writeResp *http.Response) {
+	if !providerConfig.WaitForReplication {
+		return
+	}
+	// Compare against the admin node's clock rather than ours. The Date header only has second
+	// precision, so require engines to have updated after the end of that second.
+	changedAt := time.Now()
+	if writeResp != nil {
+		if date, err := http.ParseTime(writeResp.Header.Get("Date")); err == nil {
+			changedAt = date.Add(time.Second)
+		}
+	}
+
+	if providerConfig.ReplicationTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, providerConfig.ReplicationTimeout)
+		defer cancel()
+	}
+	for {
+		pendingNodes, err := getPendingNodes(ctx, apiClient, providerConfig, changedAt)
+		if err != nil {
+			diagnostics.AddWarning("Unable to confirm configuration replication", "An error occurred while getting engine and replica admin status: "+err.Error())
+			return
+		}
+		if len(pendingNodes) == 0 {
+			return
+		}
+		tflog.Debug(ctx, "Waiting for engines and replica admins to pick up configuration changes", map[string]interface{}{
+			"nodes": pendingNodes,
+		})
+		timer := time.NewTimer(replicationPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			diagnostics.AddWarning("Timed out waiting for configuration replication",
+				"The following nodes have not picked up the configuration change: "+strings.Join(pendingNodes, ", "))
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// Get the engines and replica admin nodes that haven't updated their configuration since the given time
+func getPendingNodes(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, changedAt time.Time) ([]string, error) {
+	engineStatus, _, err := apiClient.EnginesApi.GetEnginesStatus(AuthContext(ctx, providerConfig)).Execute()
+	if err != nil {
+		return nil, err
+	}
+	replicaAdminStatus, _, err := apiClient.HighAvailabilityApi.GetReplicaAdminsStatus(AuthContext(ctx, providerConfig)).Execute()
+	if err != nil {
+		return nil, err
+	}
+	var pendingNodes []string
+	for engineId, status := range engineStatus.GetEnginesStatus() {
+		if time.UnixMilli(status.GetLastUpdated()).Before(changedAt) {
+			pendingNodes = append(pendingNodes, "engine "+engineId)
+		}
+	}
+	for replicaAdminId, status := range replicaAdminStatus.GetReplicaAdminsStatus() {
+		if time.UnixMilli(status.GetLastUpdated()).Before(changedAt) {
+			pendingNodes = append(pendingNodes, "replica admin "+replicaAdminId)
+		}
+	}
+	sort.Strings(pendingNodes)
+	return pendingNodes, nil
+}
+
+// Minimum PingAccess versions of resources and attributes that aren't supported by every release
+const (
+	AcmeMinimumVersion                    = "6.3"
+	WebSessionPkceChallengeMinimumVersion = "7.1"
+)
+
+// Check that the connected PingAccess server supports a resource or attribute added in the given version, adding
+// an error diagnostic if it doesn't. The check is skipped when the server version couldn't be determined.
+func CheckServerVersion(diagnostics *diag.Diagnostics, serverVersion, minimumVersion, name string) bool {
+	if serverVersion == "" || internaltypes.CompareVersions(serverVersion, minimumVersion) >= 0 {
+		return true
+	}
+	diagnostics.AddError("Not supported by this PingAccess version",
+		name+" requires PingAccess "+minimumVersion+" or later, but the connected server is running PingAccess "+serverVersion)
+	return false
+}
+
+// Check that exactly one of the id attribute and the lookup attribute is set on a data source
+func CheckDataSourceLookup(diagnostics *diag.Diagnostics, id, lookupValue attr.Value, lookupAttributeName string) bool {
+	if internaltypes.IsDefined(id) == internaltypes.IsDefined(lookupValue) {
+		diagnostics.AddError("Invalid data source configuration", "Exactly one of id or "+lookupAttributeName+" must be set")
+		return false
+	}
+	return true
+}
+
+// Check that a data source lookup matched exactly one object, adding an error diagnostic if it didn't
+func CheckLookupMatches(diagnostics *diag.Diagnostics, objectType, lookupDescription string, matches int) bool {
+	if matches == 1 {
+		return true
+	}
+	if matches == 0 {
+		diagnostics.AddError(objectType+" not found", "No "+objectType+" was found with "+lookupDescription)
+	} else {
+		diagnostics.AddError("Multiple objects found", fmt.Sprintf("%d objects of type %s were found with %s. Use id to select one.", matches, objectType, lookupDescription))
+	}
+	return false
+}
+
+// Remove a resource from state with a warning when its object was not found, so that Terraform plans to
+// recreate an object that was deleted outside of Terraform. Returns true if the resource was removed.
+func RemoveResourceIfNotFound(ctx context.Context, resp *resource.ReadResponse, objectType string, httpResp *http.Response) bool {
+	if httpResp == nil || httpResp.StatusCode != http.StatusNotFound {
+		return false
+	}
+	tflog.Warn(ctx, objectType+" not found, removing it from state")
+	resp.Diagnostics.AddWarning(objectType+" not found",
+		"The "+objectType+" no longer exists on the PingAccess server, likely because it was deleted outside of Terraform. It has been removed from state and will be recreated on the next apply.")
+	resp.State.RemoveResource(ctx)
+	return true
+}
+
+// Prefix of import ids that identify an object by its name rather than by its id
+const ImportByNamePrefix = "name:"
+
+// Prefix of import ids that identify a certificate or key pair by its alias rather than by its id
+const ImportByAliasPrefix = "alias:"
+
+// Import a resource using the import id as its id or, when the import id is "name:<name>", using the id of the
+// single object that lookupIds finds with exactly that name
+func ImportByIdOrName(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, objectType string, lookupIds func(name string) ([]string, *http.Response, error)) {
+	ImportByIdOrLookup(ctx, req, resp, objectType, ImportByNamePrefix, "name", lookupIds)
+}
+
+// Import a resource using the import id as its id or, when the import id starts with prefix, using the id of the
+// single object that lookupIds finds with exactly the rest of the import id as its lookupAttribute
+func ImportByIdOrLookup(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, objectType, prefix, lookupAttribute string, lookupIds func(value string) ([]string, *http.Response, error)) {
+	if !strings.HasPrefix(req.ID, prefix) {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+	value := strings.TrimPrefix(req.ID, prefix)
+	ids, httpResp, err := lookupIds(value)
+	if err != nil {
+		ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a "+objectType+" to import", err, httpResp)
+		return
+	}
+	if !CheckLookupMatches(&resp.Diagnostics, objectType, lookupAttribute+" \""+value+"\"", len(ids)) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ids[0])...)
+}
+
+// Number of items requested per page when listing objects through the admin API
+const ListPageSize int32 = 100
+
+// Request successive pages of an admin API list until a page comes back short, returning the items from
+// every page. A server that ignores the paging parameters returns more items than were asked for, or the
+// same page again, so stop in those cases too rather than loop forever. A repeated page is dropped rather
+// than collected, so its items aren't returned twice.
+func ListAllPages[T any](fetchPage func(page int32) ([]T, *http.Response, error)) ([]T, *http.Response, error) {
+	var allItems, previousItems []T
+	for page := int32(1); ; page++ {
+		items, httpResp, err := fetchPage(page)
+		if err != nil {
+			return allItems, httpResp, err
+		}
+		if page > 1 && reflect.DeepEqual(items, previousItems) {
+			return allItems, httpResp, nil
+		}
+		allItems = append(allItems, items...)
+		if len(items) != int(ListPageSize) {
+			return allItems, httpResp, nil
+		}
+		previousItems = items
+	}
+}
+
+// Check that the sort order of a list data source is one the admin API accepts
+func CheckListOrder(diagnostics *diag.Diagnostics, order types.String) bool {
+	if !internaltypes.IsDefined(order) || order.ValueString() == "ASC" || order.ValueString() == "DESC" {
+		return true
+	}
+	diagnostics.AddAttributeError(path.Root("order"), "Invalid sort order", "order must be either ASC or DESC, got "+order.ValueString())
+	return false
+}
+
+// Attribute types of the subject alternative names reported for certificates and key pairs
+var SubjectAlternativeNameAttrTypes = map[string]attr.Type{
+	"name":  types.StringType,
+	"value": types.StringType,
+}
+
+// Get a types.List of subject alternative name objects from the subject alternative names returned by the admin API
+func GetSubjectAlternativeNames(sans []client.SanType, diagnostics *diag.Diagnostics) types.List {
+	sanValues := []attr.Value{}
+	for _, san := range sans {
+		sanValue, diags := types.ObjectValue(SubjectAlternativeNameAttrTypes, map[string]attr.Value{
+			"name":  internaltypes.StringTypeOrNil(san.Name, false),
+			"value": internaltypes.StringTypeOrNil(san.Value, false),
+		})
+		diagnostics.Append(diags...)
+		sanValues = append(sanValues, sanValue)
+	}
+	sanList, diags := types.ListValue(types.ObjectType{AttrTypes: SubjectAlternativeNameAttrTypes}, sanValues)
+	diagnostics.Append(diags...)
+	return sanList
+}
+
 // Error from PA API
 type pingAccessError struct {
-	Schemas []string `json:"schemas"`
-	Status  string   `json:"status"`
-	Detail  string   `json:"detail"`
+	ResultId string              `json:"resultId"`
+	Flash    []string            `json:"flash"`
+	Form     map[string][]string `json:"form"`
+	// Some endpoints report field errors as validationErrors rather than form
+	ValidationErrors map[string][]string `json:"validationErrors"`
 }
 
 // Report an HTTP error
@@ -43,7 +282,9 @@ func ReportHttpError(ctx context.Context, diagnostics *diag.Diagnostics, errorSu
 			var paError pingAccessError
 			internalError = json.Unmarshal(body, &paError)
 			if internalError == nil {
-				diagnostics.AddError(errorSummary, err.Error()+" - Detail: "+string(body))
+				if !reportPingAccessError(diagnostics, errorSummary, err, paError) {
+					diagnostics.AddError(errorSummary, err.Error()+" - Detail: "+string(body))
+				}
 				httpErrorPrinted = true
 			}
 		}
@@ -55,3 +296,53 @@ func ReportHttpError(ctx context.Context, diagnostics *diag.Diagnostics, errorSu
 		diagnostics.AddError(errorSummary, err.Error())
 	}
 }
+
+// Report the flash messages and field errors from a PingAccess error response, with each field error
+// attached to the matching attribute. Returns false if the response contained neither.
+func reportPingAccessError(diagnostics *diag.Diagnostics, errorSummary string, err error, paError pingAccessError) bool {
+	fieldErrors := map[string][]string{}
+	for field, messages := range paError.Form {
+		fieldErrors[field] = append(fieldErrors[field], messages...)
+	}
+	for field, messages := range paError.ValidationErrors {
+		fieldErrors[field] = append(fieldErrors[field], messages...)
+	}
+	if len(paError.Flash) == 0 && len(fieldErrors) == 0 {
+		return false
+	}
+
+	detail := err.Error()
+	if len(paError.Flash) > 0 {
+		detail += " - " + strings.Join(paError.Flash, " ")
+	}
+	diagnostics.AddError(errorSummary, detail)
+
+	fields := make([]string, 0, len(fieldErrors))
+	for field := range fieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		for _, message := range fieldErrors[field] {
+			diagnostics.AddAttributeError(fieldErrorPath(field), errorSummary, message)
+		}
+	}
+	return true
+}
+
+// Get the attribute path for a PingAccess field name, such as "revocationChecking.crlChecking" or "targets[0]"
+func fieldErrorPath(field string) path.Path {
+	var attributePath path.Path
+	for i, segment := range strings.Split(field, ".") {
+		if index := strings.Index(segment, "["); index >= 0 {
+			segment = segment[:index]
+		}
+		name := internaltypes.CamelCaseToUnderscores(segment)
+		if i == 0 {
+			attributePath = path.Root(name)
+		} else {
+			attributePath = attributePath.AtName(name)
+		}
+	}
+	return attributePath
+}
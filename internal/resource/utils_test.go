@@ -0,0 +1,92 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestCheckServerVersion(t *testing.T) {
+	tests := []struct {
+		serverVersion  string
+		minimumVersion string
+		supported      bool
+	}{
+		{"", "7.1", true},
+		{"6.3.2", "6.3", true},
+		{"7.2.0", "7.1", true},
+		{"7.1", "7.1", true},
+		{"6.3.2", "7.1", false},
+		{"7.0.5", "7.1", false},
+	}
+	for _, test := range tests {
+		var diagnostics diag.Diagnostics
+		supported := CheckServerVersion(&diagnostics, test.serverVersion, test.minimumVersion, "example")
+		if supported != test.supported {
+			t.Errorf("server version %q with minimum %q: expected supported to be %t", test.serverVersion, test.minimumVersion, test.supported)
+		}
+		if diagnostics.HasError() == test.supported {
+			t.Errorf("server version %q with minimum %q: unexpected diagnostics %v", test.serverVersion, test.minimumVersion, diagnostics)
+		}
+	}
+}
+
+// Build a page of count items, numbered from first
+func testPage(first, count int) []string {
+	items := make([]string, count)
+	for i := range items {
+		items[i] = fmt.Sprintf("item%d", first+i)
+	}
+	return items
+}
+
+func TestListAllPages(t *testing.T) {
+	fullPage := int(ListPageSize)
+	tests := []struct {
+		name          string
+		pages         [][]string
+		expectedItems []string
+	}{
+		{"single short page", [][]string{testPage(0, 3)}, testPage(0, 3)},
+		{"full pages then short page", [][]string{testPage(0, fullPage), testPage(fullPage, fullPage), testPage(2*fullPage, 5)}, testPage(0, 2*fullPage+5)},
+		{"full pages then empty page", [][]string{testPage(0, fullPage), {}}, testPage(0, fullPage)},
+		{"page size ignored", [][]string{testPage(0, fullPage+50)}, testPage(0, fullPage+50)},
+		{"page number ignored", [][]string{testPage(0, fullPage), testPage(0, fullPage), testPage(0, fullPage)}, testPage(0, fullPage)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			items, _, err := ListAllPages(func(page int32) ([]string, *http.Response, error) {
+				if int(page) > len(test.pages) {
+					t.Fatalf("requested page %d, beyond the last page", page)
+				}
+				return test.pages[page-1], &http.Response{}, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(items, test.expectedItems) {
+				t.Errorf("expected %d items to be collected, got %d: %v", len(test.expectedItems), len(items), items)
+			}
+		})
+	}
+}
+
+func TestListAllPagesError(t *testing.T) {
+	fetchErr := errors.New("unavailable")
+	items, _, err := ListAllPages(func(page int32) ([]string, *http.Response, error) {
+		if page > 1 {
+			return nil, nil, fetchErr
+		}
+		return testPage(0, int(ListPageSize)), &http.Response{}, nil
+	})
+	if err != fetchErr {
+		t.Errorf("expected the page error to be returned, got %v", err)
+	}
+	if len(items) != int(ListPageSize) {
+		t.Errorf("expected the items read before the error to be returned, got %d", len(items))
+	}
+}
@@ -0,0 +1,165 @@
+package virtualhost
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &virtualhostDataSource{}
+	_ datasource.DataSourceWithConfigure = &virtualhostDataSource{}
+)
+
+// VirtualHostDataSource is a helper function to simplify the provider implementation.
+func VirtualHostDataSource() datasource.DataSource {
+	return &virtualhostDataSource{}
+}
+
+// virtualhostDataSource is the data source implementation.
+type virtualhostDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type virtualhostDataSourceModel struct {
+	Id                        types.String `tfsdk:"id"`
+	Host                      types.String `tfsdk:"host"`
+	Port                      types.Int64  `tfsdk:"port"`
+	AgentResourceCacheTTL     types.Int64  `tfsdk:"agent_resource_cache_ttl"`
+	KeyPairId                 types.Int64  `tfsdk:"keypair_id"`
+	TrustedCertificateGroupId types.Int64  `tfsdk:"trusted_certificate_group_id"`
+}
+
+// Schema defines the schema for the data source.
+func (d *virtualhostDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Virtual Host by id or host and port.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the Virtual Host. Exactly one of id or host must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"host": schema.StringAttribute{
+				Description: "Exact host of the Virtual Host. Exactly one of id or host must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"port": schema.Int64Attribute{
+				Description: "Port of the Virtual Host. Required when looking up by host.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"agent_resource_cache_ttl": schema.Int64Attribute{
+				Computed: true,
+			},
+			"keypair_id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"trusted_certificate_group_id": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *virtualhostDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtualhost"
+}
+
+func (d *virtualhostDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	d.providerConfig = providerCfg.ProviderConfig
+	d.apiClient = providerCfg.ApiClient
+}
+
+func (d *virtualhostDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state virtualhostDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.CheckDataSourceLookup(&resp.Diagnostics, state.Id, state.Host, "host") {
+		return
+	}
+	if internaltypes.IsDefined(state.Host) && !internaltypes.IsDefined(state.Port) {
+		resp.Diagnostics.AddError("Invalid data source configuration", "port must be set when looking up a Virtual Host by host")
+		return
+	}
+
+	var virtualHost *client.VirtualHost
+	if internaltypes.IsDefined(state.Id) {
+		apiReadVirtualHost, httpResp, err := d.apiClient.VirtualhostsApi.GetVirtualHost(config.AuthContext(ctx, d.providerConfig), state.Id.ValueString()).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Virtual Host", err, httpResp)
+			return
+		}
+		virtualHost = apiReadVirtualHost
+	} else {
+		host := state.Host.ValueString()
+		port := state.Port.ValueInt64()
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.VirtualHost, *http.Response, error) {
+			apiReadVirtualHosts, httpResp, err := d.apiClient.VirtualhostsApi.GetVirtualHosts(config.AuthContext(ctx, d.providerConfig)).Filter(host).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadVirtualHosts.GetItems(), httpResp, nil
+		})
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Virtual Host", err, httpResp)
+			return
+		}
+		// Only exact matches count
+		var matches []client.VirtualHost
+		for _, item := range items {
+			if item.Host == host && item.Port == port {
+				matches = append(matches, item)
+			}
+		}
+		if !config.CheckLookupMatches(&resp.Diagnostics, "Virtual Host", fmt.Sprintf("host \"%s\" and port %d", host, port), len(matches)) {
+			return
+		}
+		virtualHost = &matches[0]
+	}
+	// Log response JSON
+	responseJson, err := virtualHost.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	state = readVirtualHostDataSourceResponse(ctx, virtualHost)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read the API response into the data source model, reusing the resource mapper
+func readVirtualHostDataSourceResponse(ctx context.Context, r *client.VirtualHost) virtualhostDataSourceModel {
+	var model virtualhostResourceModel
+	readVirtualHostResponse(ctx, r, &model, &model)
+	return virtualhostDataSourceModel{
+		Id:                        model.Id,
+		Host:                      model.Host,
+		Port:                      model.Port,
+		AgentResourceCacheTTL:     model.AgentResourceCacheTTL,
+		KeyPairId:                 model.KeyPairId,
+		TrustedCertificateGroupId: model.TrustedCertificateGroupId,
+	}
+}
@@ -0,0 +1,133 @@
+package virtualhost
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &virtualHostsDataSource{}
+	_ datasource.DataSourceWithConfigure = &virtualHostsDataSource{}
+)
+
+// VirtualHostsDataSource is a helper function to simplify the provider implementation.
+func VirtualHostsDataSource() datasource.DataSource {
+	return &virtualHostsDataSource{}
+}
+
+// virtualHostsDataSource is the data source implementation.
+type virtualHostsDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type virtualHostsDataSourceModel struct {
+	Id      types.String                 `tfsdk:"id"`
+	Filter  types.String                 `tfsdk:"filter"`
+	SortKey types.String                 `tfsdk:"sort_key"`
+	Order   types.String                 `tfsdk:"order"`
+	Items   []virtualhostDataSourceModel `tfsdk:"items"`
+}
+
+// Schema defines the schema for the data source.
+func (d *virtualHostsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Lists the Virtual Hosts matching the given filters.",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Description: "The matching Virtual Hosts.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"host": schema.StringAttribute{
+							Computed: true,
+						},
+						"port": schema.Int64Attribute{
+							Computed: true,
+						},
+						"agent_resource_cache_ttl": schema.Int64Attribute{
+							Computed: true,
+						},
+						"keypair_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"trusted_certificate_group_id": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+	config.AddListDataSourceSchema(&schema, "")
+	resp.Schema = schema
+}
+
+// Metadata returns the data source type name.
+func (d *virtualHostsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_hosts"
+}
+
+func (d *virtualHostsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	d.providerConfig = providerCfg.ProviderConfig
+	d.apiClient = providerCfg.ApiClient
+}
+
+func (d *virtualHostsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state virtualHostsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.CheckListOrder(&resp.Diagnostics, state.Order) {
+		return
+	}
+
+	state.Items = []virtualhostDataSourceModel{}
+	items, httpResp, err := config.ListAllPages(func(page int32) ([]client.VirtualHost, *http.Response, error) {
+		apiListVirtualHosts := d.apiClient.VirtualhostsApi.GetVirtualHosts(config.AuthContext(ctx, d.providerConfig)).Page(page).NumberPerPage(config.ListPageSize)
+		if internaltypes.IsDefined(state.Filter) {
+			apiListVirtualHosts = apiListVirtualHosts.Filter(state.Filter.ValueString())
+		}
+		if internaltypes.IsDefined(state.SortKey) {
+			apiListVirtualHosts = apiListVirtualHosts.SortKey(state.SortKey.ValueString())
+		}
+		if internaltypes.IsDefined(state.Order) {
+			apiListVirtualHosts = apiListVirtualHosts.Order(state.Order.ValueString())
+		}
+		apiReadVirtualHosts, httpResp, err := apiListVirtualHosts.Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		return apiReadVirtualHosts.GetItems(), httpResp, nil
+	})
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while listing Virtual Hosts", err, httpResp)
+		return
+	}
+	for i := range items {
+		state.Items = append(state.Items, readVirtualHostDataSourceResponse(ctx, &items[i]))
+	}
+
+	state.Id = types.StringValue("virtual_hosts")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
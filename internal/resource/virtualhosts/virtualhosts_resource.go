@@ -2,8 +2,12 @@ package virtualhost
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -35,12 +39,13 @@ type virtualhostResource struct {
 }
 
 type virtualhostResourceModel struct {
-	Id                        types.String `tfsdk:"id"`
-	AgentResourceCacheTTL     types.Int64  `tfsdk:"agent_resource_cache_ttl"`
-	Host                      types.String `tfsdk:"host"`
-	KeyPairId                 types.Int64  `tfsdk:"keypair_id"`
-	Port                      types.Int64  `tfsdk:"port"`
-	TrustedCertificateGroupId types.Int64  `tfsdk:"trusted_certificate_group_id"`
+	Id                        types.String   `tfsdk:"id"`
+	AgentResourceCacheTTL     types.Int64    `tfsdk:"agent_resource_cache_ttl"`
+	Host                      types.String   `tfsdk:"host"`
+	KeyPairId                 types.Int64    `tfsdk:"keypair_id"`
+	Port                      types.Int64    `tfsdk:"port"`
+	TrustedCertificateGroupId types.Int64    `tfsdk:"trusted_certificate_group_id"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -98,6 +103,12 @@ func virtualhostResourceSchema(ctx context.Context, req resource.SchemaRequest,
 	if setOptionalToComputed {
 		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"port", "host"})
 	}
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
 	resp.Schema = schema
 }
 func addOptionalVirtualHostFields(ctx context.Context, addRequest *client.VirtualHost, plan virtualhostResourceModel) error {
@@ -155,6 +166,13 @@ func (r *virtualhostResource) Create(ctx context.Context, req resource.CreateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
 	createVirtualHost := client.NewVirtualHost(plan.Host.ValueString(), plan.Port.ValueInt64())
 	err := addOptionalVirtualHostFields(ctx, createVirtualHost, plan)
@@ -167,13 +185,14 @@ func (r *virtualhostResource) Create(ctx context.Context, req resource.CreateReq
 		tflog.Debug(ctx, "Add request: "+string(requestJson))
 	}
 
-	apiCreateVirtualHost := r.apiClient.VirtualhostsApi.AddVirtualHost(config.ProviderBasicAuthContext(ctx, r.providerConfig))
+	apiCreateVirtualHost := r.apiClient.VirtualhostsApi.AddVirtualHost(config.AuthContext(ctx, r.providerConfig))
 	apiCreateVirtualHost = apiCreateVirtualHost.VirtualHost(*createVirtualHost)
 	listenerResponse, httpResp, err := r.apiClient.VirtualhostsApi.AddVirtualHostExecute(apiCreateVirtualHost)
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the VirtualHost", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
 	responseJson, err := listenerResponse.MarshalJSON()
 	if err == nil {
 		tflog.Debug(ctx, "Add response: "+string(responseJson))
@@ -183,6 +202,7 @@ func (r *virtualhostResource) Create(ctx context.Context, req resource.CreateReq
 	var state virtualhostResourceModel
 
 	readVirtualHostResponse(ctx, listenerResponse, &state, &plan)
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -202,8 +222,17 @@ func readVirtualHost(ctx context.Context, req resource.ReadRequest, resp *resour
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	apiReadVirtualHost, httpResp, err := apiClient.VirtualhostsApi.GetVirtualHost(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
-
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	apiReadVirtualHost, httpResp, err := apiClient.VirtualhostsApi.GetVirtualHost(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "Virtual Host", httpResp) {
+		return
+	}
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a VirtualHost", err, httpResp)
 		return
@@ -239,11 +268,18 @@ func updateVirtualHost(ctx context.Context, req resource.UpdateRequest, resp *re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
 	// Get the current state to see how any attributes are changing
 	var state virtualhostResourceModel
 	req.State.Get(ctx, &state)
-	UpdateVirtualHost := apiClient.VirtualhostsApi.UpdateVirtualHost(config.ProviderBasicAuthContext(ctx, providerConfig), plan.Id.ValueString())
+	UpdateVirtualHost := apiClient.VirtualhostsApi.UpdateVirtualHost(config.AuthContext(ctx, providerConfig), plan.Id.ValueString())
 	CreateUpdateRequest := client.NewVirtualHost(plan.Host.ValueString(), plan.Port.ValueInt64())
 	err := addOptionalVirtualHostFields(ctx, CreateUpdateRequest, plan)
 	if err != nil {
@@ -260,6 +296,7 @@ func updateVirtualHost(ctx context.Context, req resource.UpdateRequest, resp *re
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating VirtualHost", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 	// Log response JSON
 	responseJson, err := updateVirtualHostResponse.MarshalJSON()
 	if err == nil {
@@ -269,6 +306,7 @@ func updateVirtualHost(ctx context.Context, req resource.UpdateRequest, resp *re
 	readVirtualHostResponse(ctx, updateVirtualHostResponse, &state, &plan)
 
 	// Update computed values
+	state.Timeouts = plan.Timeouts
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -289,18 +327,67 @@ func deleteVirtualHost(ctx context.Context, req resource.DeleteRequest, resp *re
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	httpResp, err := apiClient.VirtualhostsApi.DeleteVirtualHost(config.ProviderBasicAuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	httpResp, err := apiClient.VirtualhostsApi.DeleteVirtualHost(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting a VirtualHost", err, httpResp)
 		return
 	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
 
 }
 
 func (r *virtualhostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	importLocation(ctx, req, resp)
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
 }
-func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve import ID and save to id attribute, looking the ID up first when importing by name.
+	// Virtual Hosts are named host:port, as they are shown in the PingAccess admin console.
+	if strings.HasPrefix(req.ID, config.ImportByNamePrefix) {
+		_, _, err := splitVirtualHostName(strings.TrimPrefix(req.ID, config.ImportByNamePrefix))
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid import ID", "Expected an import ID of the form name:<host>:<port>, but "+err.Error())
+			return
+		}
+	}
+	config.ImportByIdOrName(ctx, req, resp, "Virtual Host", func(name string) ([]string, *http.Response, error) {
+		host, port, err := splitVirtualHostName(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		var ids []string
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.VirtualHost, *http.Response, error) {
+			apiReadVirtualHosts, httpResp, err := apiClient.VirtualhostsApi.GetVirtualHosts(config.AuthContext(ctx, providerConfig)).Filter(host).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadVirtualHosts.GetItems(), httpResp, nil
+		})
+		for i := range items {
+			if items[i].Host == host && items[i].Port == port {
+				ids = append(ids, internaltypes.Int64PointerToString(*items[i].Id))
+			}
+		}
+		return ids, httpResp, err
+	})
+}
+
+// Split a Virtual Host name of the form host:port
+func splitVirtualHostName(name string) (string, int64, error) {
+	separator := strings.LastIndex(name, ":")
+	if separator < 0 {
+		return "", 0, fmt.Errorf("\"%s\" has no port", name)
+	}
+	portValue := name[separator+1:]
+	port, err := strconv.ParseInt(portValue, 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("port \"%s\" of \"%s\" is not a number", portValue, name)
+	}
+	return name[:separator], port, nil
 }
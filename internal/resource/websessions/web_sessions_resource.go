@@ -0,0 +1,657 @@
+package websessions
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingaccess-go-client"
+	config "github.com/pingidentity/terraform-provider-pingaccess/internal/resource"
+	internaltypes "github.com/pingidentity/terraform-provider-pingaccess/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &webSessionResource{}
+	_ resource.ResourceWithConfigure      = &webSessionResource{}
+	_ resource.ResourceWithImportState    = &webSessionResource{}
+	_ resource.ResourceWithValidateConfig = &webSessionResource{}
+	_ resource.ResourceWithModifyPlan     = &webSessionResource{}
+)
+
+// Client credentials types
+const (
+	credentialsTypeSecret        = "SECRET"
+	credentialsTypeCertificate   = "CERTIFICATE"
+	credentialsTypePrivateKeyJwt = "PRIVATE_KEY_JWT"
+)
+
+// Attribute types of the client_credentials attribute
+var (
+	clientSecretAttrTypes = map[string]attr.Type{
+		"value": types.StringType,
+	}
+	clientCredentialsAttrTypes = map[string]attr.Type{
+		"client_id":        types.StringType,
+		"credentials_type": types.StringType,
+		"key_pair_id":      types.StringType,
+		"client_secret":    types.ObjectType{AttrTypes: clientSecretAttrTypes},
+	}
+)
+
+// WebSessionResource is a helper function to simplify the provider implementation.
+func WebSessionResource() resource.Resource {
+	return &webSessionResource{}
+}
+
+// webSessionResource is the resource implementation.
+type webSessionResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+	serverVersion  string
+}
+
+type webSessionResourceModel struct {
+	Id                            types.String   `tfsdk:"id"`
+	Name                          types.String   `tfsdk:"name"`
+	Audience                      types.String   `tfsdk:"audience"`
+	ClientCredentials             types.Object   `tfsdk:"client_credentials"`
+	OidcLoginType                 types.String   `tfsdk:"oidc_login_type"`
+	Scopes                        types.Set      `tfsdk:"scopes"`
+	PkceChallengeType             types.String   `tfsdk:"pkce_challenge_type"`
+	RequestPreservationType       types.String   `tfsdk:"request_preservation_type"`
+	CookieType                    types.String   `tfsdk:"cookie_type"`
+	CookieDomain                  types.String   `tfsdk:"cookie_domain"`
+	SecureCookie                  types.Bool     `tfsdk:"secure_cookie"`
+	HttpOnlyCookie                types.Bool     `tfsdk:"http_only_cookie"`
+	SameSite                      types.String   `tfsdk:"same_site"`
+	IdleTimeoutInMinutes          types.Int64    `tfsdk:"idle_timeout_in_minutes"`
+	SessionTimeoutInMinutes       types.Int64    `tfsdk:"session_timeout_in_minutes"`
+	EnableRefreshUser             types.Bool     `tfsdk:"enable_refresh_user"`
+	RefreshUserInfoClaimsInterval types.Int64    `tfsdk:"refresh_user_info_claims_interval"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
+}
+
+type clientCredentialsModel struct {
+	ClientId        types.String `tfsdk:"client_id"`
+	CredentialsType types.String `tfsdk:"credentials_type"`
+	KeyPairId       types.String `tfsdk:"key_pair_id"`
+	ClientSecret    types.Object `tfsdk:"client_secret"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *webSessionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	webSessionResourceSchema(ctx, req, resp, false)
+}
+
+func webSessionResourceSchema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse, setOptionalToComputed bool) {
+	schema := schema.Schema{
+		Description: "Manages a Web Session.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"audience": schema.StringAttribute{
+				Description: "Audience of the PingAccess tokens issued for the Web Session.",
+				Required:    true,
+			},
+			"client_credentials": schema.SingleNestedAttribute{
+				Description: "Credentials of the OAuth client used to log users in with the OpenID Connect provider.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"client_id": schema.StringAttribute{
+						Required: true,
+					},
+					"credentials_type": schema.StringAttribute{
+						Description: "How the client authenticates, either SECRET, CERTIFICATE or PRIVATE_KEY_JWT.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"key_pair_id": schema.StringAttribute{
+						Description: "ID of the Key Pair used when credentials_type is CERTIFICATE or PRIVATE_KEY_JWT.",
+						Optional:    true,
+					},
+					"client_secret": schema.SingleNestedAttribute{
+						Description: "Secret used when credentials_type is SECRET. PingAccess never returns the secret, so changes made outside of Terraform aren't detected.",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"value": schema.StringAttribute{
+								Sensitive: true,
+								Required:  true,
+							},
+						},
+					},
+				},
+			},
+			"oidc_login_type": schema.StringAttribute{
+				Description: "OpenID Connect login flow, either Code, POST or x_post.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				Description: "Scopes requested from the OpenID Connect provider, in addition to openid.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pkce_challenge_type": schema.StringAttribute{
+				Description: "PKCE code challenge method used with the Code login type, either OFF or SHA256.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"request_preservation_type": schema.StringAttribute{
+				Description: "Requests preserved while the user authenticates, either None, POST or All.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cookie_type": schema.StringAttribute{
+				Description: "Type of the PingAccess cookie, either Encrypted or Signed.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cookie_domain": schema.StringAttribute{
+				Description: "Domain of the PingAccess cookie. When not set, the cookie is only sent to the host that set it.",
+				Optional:    true,
+			},
+			"secure_cookie": schema.BoolAttribute{
+				Description: "Whether the PingAccess cookie is only sent over HTTPS.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"http_only_cookie": schema.BoolAttribute{
+				Description: "Whether the PingAccess cookie is hidden from scripts.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"same_site": schema.StringAttribute{
+				Description: "SameSite attribute of the PingAccess cookie, either Disabled, Lax or None.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"idle_timeout_in_minutes": schema.Int64Attribute{
+				Description: "Minutes of inactivity after which the session expires.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"session_timeout_in_minutes": schema.Int64Attribute{
+				Description: "Maximum lifetime of the session in minutes.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_refresh_user": schema.BoolAttribute{
+				Description: "Whether the user's attributes are periodically refreshed from the OpenID Connect provider.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"refresh_user_info_claims_interval": schema.Int64Attribute{
+				Description: "Seconds between refreshes of the user's attributes when enable_refresh_user is true.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+
+	if setOptionalToComputed {
+		config.SetAllAttributesToOptionalAndComputed(&schema, []string{"name", "audience", "client_credentials"})
+	}
+	config.AddTimeoutsBlock(ctx, &schema, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+	resp.Schema = schema
+}
+
+// Build the client credentials sent to PingAccess, including the client secret from the plan
+func getClientCredentialsRequest(ctx context.Context, plan webSessionResourceModel, diagnostics *diag.Diagnostics) client.OAuthClientCredentials {
+	var credentials clientCredentialsModel
+	plan.ClientCredentials.As(ctx, &credentials, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})
+	credentialsRequest := client.NewOAuthClientCredentials(credentials.ClientId.ValueString())
+	if internaltypes.IsNonEmptyString(credentials.CredentialsType) {
+		credentialsRequest.CredentialsType = credentials.CredentialsType.ValueStringPointer()
+	}
+	if internaltypes.IsDefined(credentials.KeyPairId) {
+		keyPairId, err := strconv.ParseInt(credentials.KeyPairId.ValueString(), 10, 64)
+		if err != nil {
+			diagnostics.AddAttributeError(path.Root("client_credentials").AtName("key_pair_id"), "Invalid Key Pair ID", "key_pair_id must be the numeric ID of a Key Pair, got "+credentials.KeyPairId.ValueString())
+		}
+		credentialsRequest.KeyPairId = &keyPairId
+	}
+	if internaltypes.IsDefined(credentials.ClientSecret) {
+		credentialsRequest.ClientSecret = client.NewHiddenField()
+		secretValue := credentials.ClientSecret.Attributes()["value"]
+		if !secretValue.IsNull() && !secretValue.IsUnknown() {
+			credentialsRequest.ClientSecret.Value = internaltypes.InterfaceStringPointerValue(internaltypes.ConvertToPrimitive(secretValue))
+		}
+	}
+	return *credentialsRequest
+}
+
+func addOptionalWebSessionFields(ctx context.Context, addRequest *client.WebSession, plan webSessionResourceModel) error {
+	if internaltypes.IsDefined(plan.Id) {
+		addRequest.Id = internaltypes.StringToInt64Pointer(plan.Id)
+	}
+	if internaltypes.IsNonEmptyString(plan.OidcLoginType) {
+		addRequest.OidcLoginType = plan.OidcLoginType.ValueStringPointer()
+	}
+	if internaltypes.IsDefined(plan.Scopes) {
+		var scopes []string
+		plan.Scopes.ElementsAs(ctx, &scopes, false)
+		addRequest.Scopes = scopes
+	}
+	if internaltypes.IsNonEmptyString(plan.PkceChallengeType) {
+		addRequest.PkceChallengeType = plan.PkceChallengeType.ValueStringPointer()
+	}
+	if internaltypes.IsNonEmptyString(plan.RequestPreservationType) {
+		addRequest.RequestPreservationType = plan.RequestPreservationType.ValueStringPointer()
+	}
+	if internaltypes.IsNonEmptyString(plan.CookieType) {
+		addRequest.CookieType = plan.CookieType.ValueStringPointer()
+	}
+	if internaltypes.IsNonEmptyString(plan.CookieDomain) {
+		addRequest.CookieDomain = plan.CookieDomain.ValueStringPointer()
+	}
+	if internaltypes.IsDefined(plan.SecureCookie) {
+		addRequest.SecureCookie = plan.SecureCookie.ValueBoolPointer()
+	}
+	if internaltypes.IsDefined(plan.HttpOnlyCookie) {
+		addRequest.HttpOnlyCookie = plan.HttpOnlyCookie.ValueBoolPointer()
+	}
+	if internaltypes.IsNonEmptyString(plan.SameSite) {
+		addRequest.SameSite = plan.SameSite.ValueStringPointer()
+	}
+	if internaltypes.IsDefined(plan.IdleTimeoutInMinutes) {
+		addRequest.IdleTimeoutInMinutes = plan.IdleTimeoutInMinutes.ValueInt64Pointer()
+	}
+	if internaltypes.IsDefined(plan.SessionTimeoutInMinutes) {
+		addRequest.SessionTimeoutInMinutes = plan.SessionTimeoutInMinutes.ValueInt64Pointer()
+	}
+	if internaltypes.IsDefined(plan.EnableRefreshUser) {
+		addRequest.EnableRefreshUser = plan.EnableRefreshUser.ValueBoolPointer()
+	}
+	if internaltypes.IsDefined(plan.RefreshUserInfoClaimsInterval) {
+		addRequest.RefreshUserInfoClaimsInterval = plan.RefreshUserInfoClaimsInterval.ValueInt64Pointer()
+	}
+	return nil
+}
+
+// Metadata returns the resource type name.
+func (r *webSessionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_web_session"
+}
+
+func (r *webSessionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+	r.serverVersion = providerCfg.ServerVersion
+
+}
+
+func (r *webSessionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model webSessionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() || !internaltypes.IsDefined(model.ClientCredentials) {
+		return
+	}
+	var credentials clientCredentialsModel
+	model.ClientCredentials.As(ctx, &credentials, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})
+	if credentials.CredentialsType.IsUnknown() || credentials.KeyPairId.IsUnknown() || credentials.ClientSecret.IsUnknown() {
+		return
+	}
+	// Clients authenticate with a secret unless the credentials type says otherwise
+	credentialsType := credentials.CredentialsType.ValueString()
+	if credentials.CredentialsType.IsNull() {
+		credentialsType = credentialsTypeSecret
+	}
+	credentialsPath := path.Root("client_credentials")
+	switch credentialsType {
+	case credentialsTypeSecret:
+		if credentials.ClientSecret.IsNull() {
+			resp.Diagnostics.AddAttributeError(credentialsPath.AtName("client_secret"), "Missing Web Session client_secret",
+				"client_secret must be set when the credentials_type is "+credentialsTypeSecret)
+		}
+		if !credentials.KeyPairId.IsNull() {
+			resp.Diagnostics.AddAttributeError(credentialsPath.AtName("key_pair_id"), "Invalid Web Session key_pair_id",
+				"key_pair_id can only be set when the credentials_type is "+credentialsTypeCertificate+" or "+credentialsTypePrivateKeyJwt)
+		}
+	case credentialsTypeCertificate, credentialsTypePrivateKeyJwt:
+		if credentials.KeyPairId.IsNull() {
+			resp.Diagnostics.AddAttributeError(credentialsPath.AtName("key_pair_id"), "Missing Web Session key_pair_id",
+				"key_pair_id must be set when the credentials_type is "+credentialsType)
+		}
+		if !credentials.ClientSecret.IsNull() {
+			resp.Diagnostics.AddAttributeError(credentialsPath.AtName("client_secret"), "Invalid Web Session client_secret",
+				"client_secret can only be set when the credentials_type is "+credentialsTypeSecret)
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(credentialsPath.AtName("credentials_type"), "Invalid Web Session credentials_type",
+			"credentials_type must be one of "+credentialsTypeSecret+", "+credentialsTypeCertificate+" or "+credentialsTypePrivateKeyJwt)
+	}
+}
+
+func readWebSessionResponse(ctx context.Context, r *client.WebSession, state *webSessionResourceModel, expectedValues *webSessionResourceModel, diagnostics *diag.Diagnostics) {
+	state.Id = types.StringValue(internaltypes.Int64PointerToString(*r.Id))
+	state.Name = types.StringValue(r.Name)
+	state.Audience = types.StringValue(r.Audience)
+
+	// PingAccess only returns the encrypted client secret, so the expected secret is kept as is
+	clientSecret := types.ObjectNull(clientSecretAttrTypes)
+	if internaltypes.IsDefined(expectedValues.ClientCredentials) {
+		var expectedCredentials clientCredentialsModel
+		expectedValues.ClientCredentials.As(ctx, &expectedCredentials, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})
+		if internaltypes.IsDefined(expectedCredentials.ClientSecret) {
+			clientSecret = expectedCredentials.ClientSecret
+		}
+	}
+	keyPairId := types.StringNull()
+	if r.ClientCredentials.KeyPairId != nil {
+		keyPairId = types.StringValue(internaltypes.Int64PointerToString(*r.ClientCredentials.KeyPairId))
+	}
+	clientCredentials, diags := types.ObjectValue(clientCredentialsAttrTypes, map[string]attr.Value{
+		"client_id":        types.StringValue(r.ClientCredentials.ClientId),
+		"credentials_type": internaltypes.StringTypeOrNil(r.ClientCredentials.CredentialsType, false),
+		"key_pair_id":      keyPairId,
+		"client_secret":    clientSecret,
+	})
+	diagnostics.Append(diags...)
+	state.ClientCredentials = clientCredentials
+
+	state.OidcLoginType = internaltypes.StringTypeOrNil(r.OidcLoginType, false)
+	state.Scopes = internaltypes.GetStringSet(r.Scopes)
+	state.PkceChallengeType = internaltypes.StringTypeOrNil(r.PkceChallengeType, false)
+	state.RequestPreservationType = internaltypes.StringTypeOrNil(r.RequestPreservationType, false)
+	state.CookieType = internaltypes.StringTypeOrNil(r.CookieType, false)
+	state.CookieDomain = internaltypes.StringTypeOrNil(r.CookieDomain, internaltypes.IsNonEmptyString(expectedValues.CookieDomain))
+	state.SecureCookie = internaltypes.BoolTypeOrNil(r.SecureCookie)
+	state.HttpOnlyCookie = internaltypes.BoolTypeOrNil(r.HttpOnlyCookie)
+	state.SameSite = internaltypes.StringTypeOrNil(r.SameSite, false)
+	state.IdleTimeoutInMinutes = internaltypes.Int64TypeOrNil(r.IdleTimeoutInMinutes)
+	state.SessionTimeoutInMinutes = internaltypes.Int64TypeOrNil(r.SessionTimeoutInMinutes)
+	state.EnableRefreshUser = internaltypes.BoolTypeOrNil(r.EnableRefreshUser)
+	state.RefreshUserInfoClaimsInterval = internaltypes.Int64TypeOrNil(r.RefreshUserInfoClaimsInterval)
+}
+
+func (r *webSessionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying the resource
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var pkceChallengeType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("pkce_challenge_type"), &pkceChallengeType)...)
+	if internaltypes.IsDefined(pkceChallengeType) {
+		config.CheckServerVersion(&resp.Diagnostics, r.serverVersion, config.WebSessionPkceChallengeMinimumVersion, "pkce_challenge_type")
+	}
+}
+
+func (r *webSessionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan webSessionResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	createWebSession := client.NewWebSession(plan.Name.ValueString(), plan.Audience.ValueString(), getClientCredentialsRequest(ctx, plan, &resp.Diagnostics))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := addOptionalWebSessionFields(ctx, createWebSession, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add optional properties to add request for Web Session", err.Error())
+		return
+	}
+	// The request isn't logged, as it includes the client secret
+	apiCreateWebSession := r.apiClient.WebSessionsApi.AddWebSession(config.AuthContext(ctx, r.providerConfig))
+	apiCreateWebSession = apiCreateWebSession.WebSession(*createWebSession)
+	webSessionResponse, httpResp, err := r.apiClient.WebSessionsApi.AddWebSessionExecute(apiCreateWebSession)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating a Web Session", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, r.apiClient, r.providerConfig, httpResp)
+	responseJson, err := webSessionResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Add response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	var state webSessionResourceModel
+
+	readWebSessionResponse(ctx, webSessionResponse, &state, &plan, &resp.Diagnostics)
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *webSessionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readWebSession(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func readWebSession(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	var state webSessionResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	readTimeout, diags := state.Timeouts.Read(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	apiReadWebSession, httpResp, err := apiClient.WebSessionsApi.GetWebSession(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if config.RemoveResourceIfNotFound(ctx, resp, "Web Session", httpResp) {
+		return
+	}
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while looking for a Web Session", err, httpResp)
+		return
+	}
+	// Log response JSON
+	responseJson, err := apiReadWebSession.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Read response: "+string(responseJson))
+	}
+
+	// Read the response into the state
+	readWebSessionResponse(ctx, apiReadWebSession, &state, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *webSessionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateWebSession(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func updateWebSession(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from plan
+	var plan webSessionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Get the current state to see how any attributes are changing
+	var state webSessionResourceModel
+	req.State.Get(ctx, &state)
+	updateWebSession := apiClient.WebSessionsApi.UpdateWebSession(config.AuthContext(ctx, providerConfig), plan.Id.ValueString())
+	createUpdateRequest := client.NewWebSession(plan.Name.ValueString(), plan.Audience.ValueString(), getClientCredentialsRequest(ctx, plan, &resp.Diagnostics))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := addOptionalWebSessionFields(ctx, createUpdateRequest, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add optional properties to update request for Web Session", err.Error())
+		return
+	}
+	// The request isn't logged, as it includes the client secret
+	updateWebSession = updateWebSession.WebSession(*createUpdateRequest)
+	updateWebSessionResponse, httpResp, err := apiClient.WebSessionsApi.UpdateWebSessionExecute(updateWebSession)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating a Web Session", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
+	// Log response JSON
+	responseJson, err := updateWebSessionResponse.MarshalJSON()
+	if err == nil {
+		tflog.Debug(ctx, "Update response: "+string(responseJson))
+	}
+	// Read the response
+	readWebSessionResponse(ctx, updateWebSessionResponse, &state, &plan, &resp.Diagnostics)
+
+	// Update computed values
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *webSessionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	deleteWebSession(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func deleteWebSession(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve values from state
+	var state webSessionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, config.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	httpResp, err := apiClient.WebSessionsApi.DeleteWebSession(config.AuthContext(ctx, providerConfig), state.Id.ValueString()).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting a Web Session", err, httpResp)
+		return
+	}
+	config.WaitForReplication(ctx, &resp.Diagnostics, apiClient, providerConfig, httpResp)
+}
+
+func (r *webSessionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importLocation(ctx, req, resp, r.apiClient, r.providerConfig)
+}
+
+func importLocation(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration) {
+	// Retrieve import ID and save to id attribute, looking the ID up first when importing by name
+	config.ImportByIdOrName(ctx, req, resp, "Web Session", func(name string) ([]string, *http.Response, error) {
+		var ids []string
+		items, httpResp, err := config.ListAllPages(func(page int32) ([]client.WebSession, *http.Response, error) {
+			apiReadWebSessions, httpResp, err := apiClient.WebSessionsApi.GetWebSessions(config.AuthContext(ctx, providerConfig)).Name(name).Page(page).NumberPerPage(config.ListPageSize).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return apiReadWebSessions.GetItems(), httpResp, nil
+		})
+		for i := range items {
+			if items[i].Name == name {
+				ids = append(ids, internaltypes.Int64PointerToString(*items[i].Id))
+			}
+		}
+		return ids, httpResp, err
+	})
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -63,6 +64,16 @@ func GetInt64Set(values []int64) types.Set {
 	return set
 }
 
+// Get a types.List from a slice of int64, keeping its order
+func GetInt64List(values []int64) types.List {
+	listValues := make([]attr.Value, len(values))
+	for i := 0; i < len(values); i++ {
+		listValues[i] = types.Int64Value(values[i])
+	}
+	list, _ := types.ListValue(types.Int64Type, listValues)
+	return list
+}
+
 // Get a types.Set from a slice of int64 or null set
 func GetInt64SetOrNull(values []int64) types.Set {
 	if len(values) >= 1 {
@@ -205,6 +216,15 @@ func Int64TypeOrNil(i *int64) types.Int64 {
 	return types.Int64Value(int64(*i))
 }
 
+// Get an RFC 3339 types.String from a timestamp in milliseconds since the epoch, handling if the pointer is nil
+func TimestampTypeOrNil(millis *int64) types.String {
+	if millis == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(time.UnixMilli(*millis).UTC().Format(time.RFC3339))
+}
+
 // Get a types.Int64 from the given interface, handling if the pointer is nil
 func Int64InterfaceTypeOrNil(i interface{}) types.Int64 {
 	if i == nil {
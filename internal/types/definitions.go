@@ -1,16 +1,49 @@
 package types
 
-import client "github.com/pingidentity/pingaccess-go-client"
+import (
+	"time"
+
+	client "github.com/pingidentity/pingaccess-go-client"
+)
 
 // Configuration used by the provider and resources
 type ProviderConfiguration struct {
-	HttpsHost string
-	Username  string
-	Password  string
+	HttpsHost                    string
+	Username                     string
+	Password                     string
+	CaCertificatePem             string
+	ServerCertificateFingerprint string
+	TlsServerName                string
+	InsecureTrustAll             bool
+	ClientCertificatePem         string
+	ClientPrivateKeyPem          string
+	ClientPkcs12                 string
+	ClientPkcs12Password         string
+	OAuthTokenUrl                string
+	OAuthClientId                string
+	OAuthClientSecret            string
+	OAuthScopes                  []string
+	OAuthAccessToken             string
+	RequestTimeout               time.Duration
+	MaxRetries                   int64
+	RetryMinBackoff              time.Duration
+	RetryMaxBackoff              time.Duration
+	RetryableStatusCodes         []int64
+	RetryNonIdempotentRequests   bool
+	MaxConcurrentWrites          int64
+	MaxRequestsPerSecond         float64
+	WaitForReplication           bool
+	ReplicationTimeout           time.Duration
+	HttpProxy                    string
+	NoProxy                      string
+	ExtraHeaders                 map[string]string
+	AdminApiPath                 string
 }
 
 // Configuration passed to resources
 type ResourceConfiguration struct {
 	ProviderConfig ProviderConfiguration
 	ApiClient      *client.APIClient
+	// Version of the connected PingAccess server, or empty if it couldn't be determined
+	ServerVersion string
 }
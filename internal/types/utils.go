@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -102,6 +103,36 @@ func FloatSetsEqual(a, b []float64) bool {
 	return true
 }
 
+// Compare two dotted version strings such as "7.2.0.1", returning -1, 0 or 1.
+// Missing components are treated as zero, so "7.2" is equal to "7.2.0".
+func CompareVersions(a, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		aPart := versionPart(aParts, i)
+		bPart := versionPart(bParts, i)
+		if aPart < bPart {
+			return -1
+		}
+		if aPart > bPart {
+			return 1
+		}
+	}
+	return 0
+}
+
+// Get the numeric value of a version component, ignoring any non-numeric suffix such as "-SNAPSHOT"
+func versionPart(parts []string, index int) int {
+	if index >= len(parts) {
+		return 0
+	}
+	digits := strings.TrimRightFunc(parts[index], func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	value, _ := strconv.Atoi(digits)
+	return value
+}
+
 func CamelCaseToUnderscores(s string) string {
 	re, _ := regexp.Compile(`([A-Z])`)
 	res := re.ReplaceAllStringFunc(s, func(m string) string {